@@ -0,0 +1,203 @@
+// Package gitlab - merge request approvals
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type (
+	// MergeRequestApprovals entity
+	MergeRequestApprovals struct {
+		ID                int        `json:"id"`
+		IID               int        `json:"iid"`
+		ProjectID         int        `json:"project_id"`
+		Title             string     `json:"title"`
+		State             string     `json:"state"`
+		Approved          bool       `json:"approved"`
+		ApprovalsRequired int        `json:"approvals_required"`
+		ApprovalsLeft     int        `json:"approvals_left"`
+		ApprovedBy        []Approver `json:"approved_by"`
+	}
+
+	// Approver entity
+	Approver struct {
+		User NoteAuthor `json:"user"`
+	}
+
+	// ApprovalState entity
+	ApprovalState struct {
+		ApprovalRulesOverwritten bool           `json:"approval_rules_overwritten"`
+		Rules                    []ApprovalRule `json:"rules"`
+	}
+
+	// ApprovalRule entity, ApprovedBy and Approved are filled in approval state only
+	ApprovalRule struct {
+		ID                   int          `json:"id"`
+		Name                 string       `json:"name"`
+		RuleType             string       `json:"rule_type"`
+		ApprovalsRequired    int          `json:"approvals_required"`
+		EligibleApprovers    []NoteAuthor `json:"eligible_approvers"`
+		Users                []NoteAuthor `json:"users"`
+		Groups               []Group      `json:"groups"`
+		ContainsHiddenGroups bool         `json:"contains_hidden_groups"`
+		ApprovedBy           []NoteAuthor `json:"approved_by"`
+		Approved             bool         `json:"approved"`
+	}
+
+	// ApprovalRuleOptions contains parameters of approval rule creation request
+	ApprovalRuleOptions struct {
+		Name               string `json:"name,omitempty"`
+		ApprovalsRequired  int    `json:"approvals_required"`
+		UserIDs            []int  `json:"user_ids,omitempty"`
+		GroupIDs           []int  `json:"group_ids,omitempty"`
+		ProtectedBranchIDs []int  `json:"protected_branch_ids,omitempty"`
+	}
+
+	// UpdateApprovalRuleOptions contains parameters of approval rule update request,
+	// nil fields are not changed, pointer to empty slice clears users, groups or protected branches
+	UpdateApprovalRuleOptions struct {
+		Name               *string `json:"name,omitempty"`
+		ApprovalsRequired  *int    `json:"approvals_required,omitempty"`
+		UserIDs            *[]int  `json:"user_ids,omitempty"`
+		GroupIDs           *[]int  `json:"group_ids,omitempty"`
+		ProtectedBranchIDs *[]int  `json:"protected_branch_ids,omitempty"`
+	}
+)
+
+func approveMergeRequest(ctx context.Context, c *client, projectID, mrID int, sha string) (MergeRequestApprovals, error) {
+	data := struct {
+		SHA string `json:"sha,omitempty"`
+	}{SHA: sha}
+
+	resp, err := c.post(ctx, fmt.Sprintf("projects/%d/merge_requests/%d/approve", projectID, mrID), data)
+	if err != nil {
+		return MergeRequestApprovals{}, err
+	}
+
+	var approvals MergeRequestApprovals
+	if err = json.Unmarshal(resp, &approvals); err != nil {
+		return MergeRequestApprovals{}, fmt.Errorf("can't unmarshal merge request approvals data: %w", err)
+	}
+
+	return approvals, nil
+}
+
+func unapproveMergeRequest(ctx context.Context, c *client, projectID, mrID int) error {
+	_, err := c.post(ctx, fmt.Sprintf("projects/%d/merge_requests/%d/unapprove", projectID, mrID), nil)
+	return err
+}
+
+func getMergeRequestApprovals(ctx context.Context, c *client, projectID, mrID int) (MergeRequestApprovals, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("projects/%d/merge_requests/%d/approvals", projectID, mrID))
+	if err != nil {
+		return MergeRequestApprovals{}, err
+	}
+
+	var approvals MergeRequestApprovals
+	if err = json.Unmarshal(resp, &approvals); err != nil {
+		return MergeRequestApprovals{}, fmt.Errorf("can't unmarshal merge request approvals data: %w", err)
+	}
+
+	return approvals, nil
+}
+
+func getMergeRequestApprovalState(ctx context.Context, c *client, projectID, mrID int) (ApprovalState, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("projects/%d/merge_requests/%d/approval_state", projectID, mrID))
+	if err != nil {
+		return ApprovalState{}, err
+	}
+
+	var state ApprovalState
+	if err = json.Unmarshal(resp, &state); err != nil {
+		return ApprovalState{}, fmt.Errorf("can't unmarshal approval state data: %w", err)
+	}
+
+	return state, nil
+}
+
+func listApprovalRules(ctx context.Context, c *client, path string) ([]ApprovalRule, error) {
+	resp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, c.featureError(ctx, FeatureApprovalRules, err)
+	}
+
+	var rules []ApprovalRule
+	if err = json.Unmarshal(resp, &rules); err != nil {
+		return nil, fmt.Errorf("can't unmarshal approval rules data: %w", err)
+	}
+
+	return rules, nil
+}
+
+func sendApprovalRule(
+	ctx context.Context,
+	c *client,
+	send func(ctx context.Context, path string, data interface{}) ([]byte, error),
+	path string,
+	opts interface{},
+) (ApprovalRule, error) {
+	resp, err := send(ctx, path, opts)
+	if err != nil {
+		return ApprovalRule{}, c.featureError(ctx, FeatureApprovalRules, err)
+	}
+
+	var rule ApprovalRule
+	if err = json.Unmarshal(resp, &rule); err != nil {
+		return ApprovalRule{}, fmt.Errorf("can't unmarshal approval rule data: %w", err)
+	}
+
+	return rule, nil
+}
+
+func listProjectApprovalRules(ctx context.Context, c *client, projectID int) ([]ApprovalRule, error) {
+	return listApprovalRules(ctx, c, fmt.Sprintf("projects/%d/approval_rules", projectID))
+}
+
+func createProjectApprovalRule(ctx context.Context, c *client, projectID int, opts ApprovalRuleOptions) (ApprovalRule, error) {
+	return sendApprovalRule(ctx, c, c.post, fmt.Sprintf("projects/%d/approval_rules", projectID), opts)
+}
+
+func updateProjectApprovalRule(
+	ctx context.Context,
+	c *client,
+	projectID, ruleID int,
+	opts UpdateApprovalRuleOptions,
+) (ApprovalRule, error) {
+	return sendApprovalRule(ctx, c, c.put, fmt.Sprintf("projects/%d/approval_rules/%d", projectID, ruleID), opts)
+}
+
+func deleteProjectApprovalRule(ctx context.Context, c *client, projectID, ruleID int) error {
+	err := c.delete(ctx, fmt.Sprintf("projects/%d/approval_rules/%d", projectID, ruleID))
+	return c.featureError(ctx, FeatureApprovalRules, err)
+}
+
+func listMergeRequestApprovalRules(ctx context.Context, c *client, projectID, mrID int) ([]ApprovalRule, error) {
+	return listApprovalRules(ctx, c, fmt.Sprintf("projects/%d/merge_requests/%d/approval_rules", projectID, mrID))
+}
+
+func createMergeRequestApprovalRule(
+	ctx context.Context,
+	c *client,
+	projectID, mrID int,
+	opts ApprovalRuleOptions,
+) (ApprovalRule, error) {
+	path := fmt.Sprintf("projects/%d/merge_requests/%d/approval_rules", projectID, mrID)
+	return sendApprovalRule(ctx, c, c.post, path, opts)
+}
+
+func updateMergeRequestApprovalRule(
+	ctx context.Context,
+	c *client,
+	projectID, mrID, ruleID int,
+	opts UpdateApprovalRuleOptions,
+) (ApprovalRule, error) {
+	path := fmt.Sprintf("projects/%d/merge_requests/%d/approval_rules/%d", projectID, mrID, ruleID)
+	return sendApprovalRule(ctx, c, c.put, path, opts)
+}
+
+func deleteMergeRequestApprovalRule(ctx context.Context, c *client, projectID, mrID, ruleID int) error {
+	err := c.delete(ctx, fmt.Sprintf("projects/%d/merge_requests/%d/approval_rules/%d", projectID, mrID, ruleID))
+	return c.featureError(ctx, FeatureApprovalRules, err)
+}
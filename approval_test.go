@@ -0,0 +1,314 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestClient_ApproveMergeRequest(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/approve", req.URL.String())
+
+			body, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"sha": "abc"}`, string(body))
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(
+				`{"iid": 20, "approved": true, "approvals_left": 0, "approved_by": [{"user": {"id": 5}}]}`,
+			))),
+			StatusCode: http.StatusCreated,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		approvals, err := client.MergeRequests().Approve(context.Background(), 10, 20, "abc")
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.MergeRequestApprovals{
+			IID:        20,
+			Approved:   true,
+			ApprovedBy: []gitlab.Approver{{User: gitlab.NoteAuthor{ID: 5}}},
+		}, approvals)
+	})
+
+	t.Run("sha mismatch", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"message": "SHA does not match HEAD of source branch"}`))),
+			StatusCode: http.StatusConflict,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		approvals, err := client.MergeRequests().Approve(context.Background(), 10, 20, "abc")
+		assert.Error(t, err)
+		assert.Equal(t, gitlab.MergeRequestApprovals{}, approvals)
+	})
+}
+
+func TestClient_UnapproveMergeRequest(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/unapprove", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{}`))),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	assert.NoError(t, client.MergeRequests().Unapprove(context.Background(), 10, 20))
+}
+
+func TestClient_GetMergeRequestApprovalState(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/approval_state", req.URL.String())
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(`{
+				"approval_rules_overwritten": true,
+				"rules": [{"id": 1, "name": "backend", "approvals_required": 1, "approved_by": [{"id": 5}], "approved": true}]
+			}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		state, err := client.MergeRequests().GetApprovalState(context.Background(), 10, 20)
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.ApprovalState{
+			ApprovalRulesOverwritten: true,
+			Rules: []gitlab.ApprovalRule{{
+				ID:                1,
+				Name:              "backend",
+				ApprovalsRequired: 1,
+				ApprovedBy:        []gitlab.NoteAuthor{{ID: 5}},
+				Approved:          true,
+			}},
+		}, state)
+	})
+
+	t.Run("error on getting approval state", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expErr)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		state, err := client.MergeRequests().GetApprovalState(context.Background(), 10, 20)
+		assert.True(t, errors.Is(err, expErr))
+		assert.Equal(t, gitlab.ApprovalState{}, state)
+	})
+}
+
+func TestClient_CreateProjectApprovalRule(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, baseUrl+"/projects/10/approval_rules", req.URL.String())
+
+			body, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"name": "security", "approvals_required": 2, "user_ids": [5], "group_ids": [7]}`, string(body))
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(
+				`{"id": 1, "name": "security", "approvals_required": 2, "users": [{"id": 5}], "groups": [{"id": 7}]}`,
+			))),
+			StatusCode: http.StatusCreated,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		rule, err := client.Projects().CreateApprovalRule(context.Background(), 10, gitlab.ApprovalRuleOptions{
+			Name:              "security",
+			ApprovalsRequired: 2,
+			UserIDs:           []int{5},
+			GroupIDs:          []int{7},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.ApprovalRule{
+			ID:                1,
+			Name:              "security",
+			ApprovalsRequired: 2,
+			Users:             []gitlab.NoteAuthor{{ID: 5}},
+			Groups:            []gitlab.Group{{ID: 7}},
+		}, rule)
+	})
+
+	t.Run("error on unmarshal response", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{"))),
+			StatusCode: http.StatusCreated,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		rule, err := client.Projects().CreateApprovalRule(context.Background(), 10, gitlab.ApprovalRuleOptions{Name: "security"})
+		assert.Error(t, err)
+		assert.Equal(t, gitlab.ApprovalRule{}, rule)
+	})
+}
+
+func TestClient_UpdateMergeRequestApprovalRule(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	for name, tc := range map[string]struct {
+		opts    func() gitlab.UpdateApprovalRuleOptions
+		expBody string
+	}{
+		"unchanged fields are omitted": {
+			opts:    func() gitlab.UpdateApprovalRuleOptions { return gitlab.UpdateApprovalRuleOptions{} },
+			expBody: `{}`,
+		},
+		"zero approvals and cleared users": {
+			opts: func() gitlab.UpdateApprovalRuleOptions {
+				approvals, userIDs, groupIDs := 0, []int{}, []int{7}
+				return gitlab.UpdateApprovalRuleOptions{
+					ApprovalsRequired: &approvals,
+					UserIDs:           &userIDs,
+					GroupIDs:          &groupIDs,
+				}
+			},
+			expBody: `{"approvals_required": 0, "user_ids": [], "group_ids": [7]}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			httpClient := new(gitlab.MockHTTPClient)
+			httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+				req, ok := args.Get(0).(*http.Request)
+				assert.True(t, ok)
+
+				assert.Equal(t, http.MethodPut, req.Method)
+				assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/approval_rules/3", req.URL.String())
+
+				body, err := ioutil.ReadAll(req.Body)
+				assert.NoError(t, err)
+				assert.JSONEq(t, tc.expBody, string(body))
+			}).Return(&http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 3, "approvals_required": 0}`))),
+				StatusCode: http.StatusOK,
+			}, nil)
+
+			client := gitlab.NewClient(
+				"test_token",
+				gitlab.WithBaseUrl(baseUrl),
+				gitlab.WithHttpClient(httpClient),
+			)
+
+			rule, err := client.MergeRequests().UpdateApprovalRule(context.Background(), 10, 20, 3, tc.opts())
+			assert.NoError(t, err)
+			assert.Equal(t, gitlab.ApprovalRule{ID: 3}, rule)
+		})
+	}
+}
+
+func TestClient_DeleteMergeRequestApprovalRule(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodDelete, req.Method)
+		assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/approval_rules/3", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		StatusCode: http.StatusNoContent,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	assert.NoError(t, client.MergeRequests().DeleteApprovalRule(context.Background(), 10, 20, 3))
+}
+
+func TestClient_ListProjectApprovalRules(t *testing.T) {
+	newClient := func(version string) gitlab.Client {
+		httpClient := newVersionHTTPClient(version)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(func(*http.Request) *http.Response {
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"message": "404 Not Found"}`))),
+				StatusCode: http.StatusNotFound,
+			}
+		}, nil)
+
+		return gitlab.NewClient("test_token", gitlab.WithHttpClient(httpClient))
+	}
+
+	t.Run("unsupported by version", func(t *testing.T) {
+		_, err := newClient("12.2.0").Projects().ListApprovalRules(context.Background(), 10)
+		assert.True(t, errors.Is(err, gitlab.ErrUnsupported))
+		assert.EqualError(t, err, "approval_rules requires gitlab 12.3, server version is 12.2.0")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := newClient("16.0.0").Projects().ListApprovalRules(context.Background(), 10)
+		assert.False(t, errors.Is(err, gitlab.ErrUnsupported))
+		assert.EqualError(t, err, "gitlab respond with 404 status code")
+	})
+}
@@ -0,0 +1,109 @@
+// Package gitlab - award emoji
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var errEmptyAwardable = errors.New("awardable is empty")
+
+type (
+	// AwardEmoji (emoji reaction) entity
+	AwardEmoji struct {
+		ID            int        `json:"id"`
+		Name          string     `json:"name"`
+		User          NoteAuthor `json:"user"`
+		CreatedAt     *Time      `json:"created_at"`
+		UpdatedAt     *Time      `json:"updated_at"`
+		AwardableID   int        `json:"awardable_id"`
+		AwardableType string     `json:"awardable_type"`
+	}
+
+	// Awardable is an entity emoji can be awarded to (merge request, issue, snippet or note of them)
+	Awardable struct {
+		path string
+	}
+)
+
+// MergeRequestAwardable returns awardable merge request
+func MergeRequestAwardable(projectID, mrID int) Awardable {
+	return Awardable{path: fmt.Sprintf("projects/%d/merge_requests/%d", projectID, mrID)}
+}
+
+// IssueAwardable returns awardable issue
+func IssueAwardable(projectID, issueID int) Awardable {
+	return Awardable{path: fmt.Sprintf("projects/%d/issues/%d", projectID, issueID)}
+}
+
+// SnippetAwardable returns awardable project snippet
+func SnippetAwardable(projectID, snippetID int) Awardable {
+	return Awardable{path: fmt.Sprintf("projects/%d/snippets/%d", projectID, snippetID)}
+}
+
+// NoteAwardable returns awardable note of merge request, issue or snippet, an empty awardable for other notes
+func NoteAwardable(projectID int, note Note) Awardable {
+	var parent Awardable
+	switch note.NoteableType {
+	case "MergeRequest":
+		parent = MergeRequestAwardable(projectID, note.NoteableIID)
+	case "Issue":
+		parent = IssueAwardable(projectID, note.NoteableIID)
+	case "Snippet":
+		parent = SnippetAwardable(projectID, note.NoteableID)
+	default:
+		return Awardable{}
+	}
+
+	return Awardable{path: fmt.Sprintf("%s/notes/%d", parent.path, note.ID)}
+}
+
+func listAwardEmoji(ctx context.Context, c *client, awardable Awardable, opts ListOptions) ([]AwardEmoji, error) {
+	if awardable.path == "" {
+		return nil, errEmptyAwardable
+	}
+
+	resp, err := c.get(ctx, withQuery(awardable.path+"/award_emoji", opts.values()))
+	if err != nil {
+		return nil, err
+	}
+
+	var awards []AwardEmoji
+	if err = json.Unmarshal(resp, &awards); err != nil {
+		return nil, fmt.Errorf("can't unmarshal award emoji data: %w", err)
+	}
+
+	return awards, nil
+}
+
+func addAwardEmoji(ctx context.Context, c *client, awardable Awardable, name string) (AwardEmoji, error) {
+	if awardable.path == "" {
+		return AwardEmoji{}, errEmptyAwardable
+	}
+
+	data := struct {
+		Name string `json:"name"`
+	}{Name: name}
+
+	resp, err := c.post(ctx, awardable.path+"/award_emoji", data)
+	if err != nil {
+		return AwardEmoji{}, err
+	}
+
+	var award AwardEmoji
+	if err = json.Unmarshal(resp, &award); err != nil {
+		return AwardEmoji{}, fmt.Errorf("can't unmarshal award emoji data: %w", err)
+	}
+
+	return award, nil
+}
+
+func removeAwardEmoji(ctx context.Context, c *client, awardable Awardable, awardID int) error {
+	if awardable.path == "" {
+		return errEmptyAwardable
+	}
+
+	return c.delete(ctx, fmt.Sprintf("%s/award_emoji/%d", awardable.path, awardID))
+}
@@ -0,0 +1,147 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestClient_ListAwardEmoji(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		for awardable, expPath := range map[gitlab.Awardable]string{
+			gitlab.MergeRequestAwardable(10, 20): "/projects/10/merge_requests/20/award_emoji",
+			gitlab.IssueAwardable(10, 30):        "/projects/10/issues/30/award_emoji",
+			gitlab.SnippetAwardable(10, 40):      "/projects/10/snippets/40/award_emoji",
+			gitlab.NoteAwardable(10, gitlab.Note{ID: 5, NoteableType: "MergeRequest", NoteableIID: 20}): "/projects/10/merge_requests/20/notes/5/award_emoji",
+			gitlab.NoteAwardable(10, gitlab.Note{ID: 6, NoteableType: "Issue", NoteableIID: 30}):        "/projects/10/issues/30/notes/6/award_emoji",
+			gitlab.NoteAwardable(10, gitlab.Note{ID: 7, NoteableType: "Snippet", NoteableID: 40}):       "/projects/10/snippets/40/notes/7/award_emoji",
+		} {
+			expPath := expPath
+
+			httpClient := new(gitlab.MockHTTPClient)
+			httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+				req, ok := args.Get(0).(*http.Request)
+				assert.True(t, ok)
+
+				assert.Equal(t, baseUrl+expPath, req.URL.String())
+			}).Return(&http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader([]byte(
+					`[{"id": 1, "name": "thumbsup", "user": {"id": 5, "username": "reviewer"}}]`,
+				))),
+				StatusCode: http.StatusOK,
+			}, nil)
+
+			client := gitlab.NewClient(
+				"test_token",
+				gitlab.WithBaseUrl(baseUrl),
+				gitlab.WithHttpClient(httpClient),
+			)
+
+			awards, err := client.AwardEmojis().List(context.Background(), awardable, gitlab.ListOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, []gitlab.AwardEmoji{{
+				ID:   1,
+				Name: "thumbsup",
+				User: gitlab.NoteAuthor{ID: 5, UserName: "reviewer"},
+			}}, awards)
+		}
+	})
+
+	t.Run("empty awardable", func(t *testing.T) {
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(new(gitlab.MockHTTPClient)),
+		)
+
+		awards, err := client.AwardEmojis().List(
+			context.Background(),
+			gitlab.NoteAwardable(10, gitlab.Note{ID: 5, NoteableType: "Commit"}),
+			gitlab.ListOptions{},
+		)
+		assert.Error(t, err)
+		assert.Equal(t, []gitlab.AwardEmoji(nil), awards)
+	})
+}
+
+func TestClient_AddAwardEmoji(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/award_emoji", req.URL.String())
+
+			body, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"name": "thumbsup"}`, string(body))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 1, "name": "thumbsup", "awardable_type": "MergeRequest"}`))),
+			StatusCode: http.StatusCreated,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		award, err := client.AwardEmojis().Add(context.Background(), gitlab.MergeRequestAwardable(10, 20), "thumbsup")
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.AwardEmoji{ID: 1, Name: "thumbsup", AwardableType: "MergeRequest"}, award)
+	})
+
+	t.Run("error on adding award emoji", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expErr)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		award, err := client.AwardEmojis().Add(context.Background(), gitlab.IssueAwardable(10, 20), "thumbsup")
+		assert.True(t, errors.Is(err, expErr))
+		assert.Equal(t, gitlab.AwardEmoji{}, award)
+	})
+}
+
+func TestClient_RemoveAwardEmoji(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodDelete, req.Method)
+		assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/notes/5/award_emoji/1", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		StatusCode: http.StatusNoContent,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	note := gitlab.Note{ID: 5, NoteableType: "MergeRequest", NoteableIID: 20}
+	assert.NoError(t, client.AwardEmojis().Remove(context.Background(), gitlab.NoteAwardable(10, note), 1))
+}
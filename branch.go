@@ -0,0 +1,97 @@
+// Package gitlab - branch
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+type (
+	// Branch entity
+	Branch struct {
+		Name               string `json:"name"`
+		Merged             bool   `json:"merged"`
+		Protected          bool   `json:"protected"`
+		Default            bool   `json:"default"`
+		DevelopersCanPush  bool   `json:"developers_can_push"`
+		DevelopersCanMerge bool   `json:"developers_can_merge"`
+		CanPush            bool   `json:"can_push"`
+		WebUrl             string `json:"web_url"`
+		Commit             Commit `json:"commit"`
+	}
+
+	// ListBranchesOptions contains parameters of branches list request
+	ListBranchesOptions struct {
+		ListOptions
+
+		// Search returns only branches containing the search string
+		Search string
+	}
+)
+
+func (opts ListBranchesOptions) values() url.Values {
+	values := opts.ListOptions.values()
+	if opts.Search != "" {
+		values.Set("search", opts.Search)
+	}
+
+	return values
+}
+
+func listBranches(ctx context.Context, c *client, projectID int, opts ListBranchesOptions) ([]Branch, error) {
+	path := fmt.Sprintf("projects/%d/repository/branches", projectID)
+	resp, err := c.get(ctx, withQuery(path, opts.values()))
+	if err != nil {
+		return nil, err
+	}
+
+	var branches []Branch
+	if err = json.Unmarshal(resp, &branches); err != nil {
+		return nil, fmt.Errorf("can't unmarshal branches data: %w", err)
+	}
+
+	return branches, nil
+}
+
+func getBranch(ctx context.Context, c *client, projectID int, branch string) (Branch, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("projects/%d/repository/branches/%s", projectID, url.PathEscape(branch)))
+	if err != nil {
+		return Branch{}, err
+	}
+
+	var b Branch
+	if err = json.Unmarshal(resp, &b); err != nil {
+		return Branch{}, fmt.Errorf("can't unmarshal branch data: %w", err)
+	}
+
+	return b, nil
+}
+
+func createBranch(ctx context.Context, c *client, projectID int, branch, ref string) (Branch, error) {
+	data := struct {
+		Branch string `json:"branch"`
+		Ref    string `json:"ref"`
+	}{Branch: branch, Ref: ref}
+
+	resp, err := c.post(ctx, fmt.Sprintf("projects/%d/repository/branches", projectID), data)
+	if err != nil {
+		return Branch{}, err
+	}
+
+	var b Branch
+	if err = json.Unmarshal(resp, &b); err != nil {
+		return Branch{}, fmt.Errorf("can't unmarshal branch data: %w", err)
+	}
+
+	return b, nil
+}
+
+func deleteBranch(ctx context.Context, c *client, projectID int, branch string) error {
+	return c.delete(ctx, fmt.Sprintf("projects/%d/repository/branches/%s", projectID, url.PathEscape(branch)))
+}
+
+func deleteMergedBranches(ctx context.Context, c *client, projectID int) error {
+	return c.delete(ctx, fmt.Sprintf("projects/%d/repository/merged_branches", projectID))
+}
@@ -0,0 +1,203 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestClient_ListBranches(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, baseUrl+"/projects/10/repository/branches?page=2&per_page=50&search=feature", req.URL.String())
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`[{"name": "feature-1"}, {"name": "feature-2"}]`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		branches, err := client.Branches().List(context.Background(), 10, gitlab.ListBranchesOptions{
+			ListOptions: gitlab.ListOptions{Page: 2, PerPage: 50},
+			Search:      "feature",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []gitlab.Branch{{Name: "feature-1"}, {Name: "feature-2"}}, branches)
+	})
+
+	t.Run("error on unmarshal response", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{"))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		branches, err := client.Branches().List(context.Background(), 10, gitlab.ListBranchesOptions{})
+		assert.Error(t, err)
+		assert.Equal(t, []gitlab.Branch(nil), branches)
+	})
+}
+
+func TestClient_GetBranch(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/projects/10/repository/branches/feature%2Ftest", req.URL.String())
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"name": "feature/test", "protected": true}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		branch, err := client.Branches().Get(context.Background(), 10, "feature/test")
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.Branch{Name: "feature/test", Protected: true}, branch)
+	})
+
+	t.Run("error on getting branch", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expErr)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		branch, err := client.Branches().Get(context.Background(), 10, "master")
+		assert.True(t, errors.Is(err, expErr))
+		assert.Equal(t, gitlab.Branch{}, branch)
+	})
+}
+
+func TestClient_CreateBranch(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, baseUrl+"/projects/10/repository/branches", req.URL.String())
+
+			body, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"branch": "feature", "ref": "master"}`, string(body))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"name": "feature"}`))),
+			StatusCode: http.StatusCreated,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		branch, err := client.Branches().Create(context.Background(), 10, "feature", "master")
+		assert.NoError(t, err)
+		assert.Equal(t, "feature", branch.Name)
+	})
+
+	t.Run("error on creating branch", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"message": "Branch already exists"}`))),
+			StatusCode: http.StatusBadRequest,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		branch, err := client.Branches().Create(context.Background(), 10, "feature", "master")
+		assert.Error(t, err)
+		assert.Equal(t, gitlab.Branch{}, branch)
+	})
+}
+
+func TestClient_DeleteBranch(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodDelete, req.Method)
+		assert.Equal(t, baseUrl+"/projects/10/repository/branches/feature", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		StatusCode: http.StatusNoContent,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	assert.NoError(t, client.Branches().Delete(context.Background(), 10, "feature"))
+}
+
+func TestClient_DeleteMergedBranches(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodDelete, req.Method)
+		assert.Equal(t, baseUrl+"/projects/10/repository/merged_branches", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		StatusCode: http.StatusAccepted,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	assert.NoError(t, client.Branches().DeleteMerged(context.Background(), 10))
+}
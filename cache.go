@@ -0,0 +1,243 @@
+// Package gitlab - cache
+package gitlab
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultCacheSize = 1000
+
+type (
+	// Cache stores responses of GET requests, implementations must be safe for concurrent use
+	Cache interface {
+		// Get returns cached response by key
+		Get(ctx context.Context, key string) (CachedResponse, bool)
+
+		// Set stores response by key
+		Set(ctx context.Context, key string, resp CachedResponse)
+
+		// Delete removes response by key
+		Delete(ctx context.Context, key string)
+	}
+
+	// CachedResponse is a response stored in cache
+	CachedResponse struct {
+		Body []byte
+		ETag string
+		// ExpiresAt is a time until response is served without request to gitlab,
+		// expired responses with ETag are revalidated by conditional requests
+		ExpiresAt time.Time
+	}
+
+	// CacheOptions contains parameters of response caching
+	CacheOptions struct {
+		// TTL is a time responses are served from cache without revalidation
+		TTL time.Duration
+		// SkipPaths are prefixes of request paths (e.g. "projects/10/merge_requests") which are never cached
+		SkipPaths []string
+	}
+
+	responseCache struct {
+		cache Cache
+		opts  CacheOptions
+		now   func() time.Time
+
+		// generations of url paths are part of keys, they are incremented by modifying requests
+		// to invalidate responses of the path requested with any query
+		mu          sync.Mutex
+		generations map[string]uint64
+	}
+
+	lruCache struct {
+		mu    sync.Mutex
+		size  int
+		items map[string]*list.Element
+		order *list.List
+	}
+
+	lruItem struct {
+		key  string
+		resp CachedResponse
+	}
+)
+
+// NewLRUCache returns in-memory cache keeping up to size (1000 if not positive) most recently used responses
+func NewLRUCache(size int) Cache {
+	if size <= 0 {
+		size = defaultCacheSize
+	}
+
+	return &lruCache{
+		size:  size,
+		items: map[string]*list.Element{},
+		order: list.New(),
+	}
+}
+
+func (c *lruCache) Get(_ context.Context, key string) (CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, has := c.items[key]
+	if !has {
+		return CachedResponse{}, false
+	}
+
+	c.order.MoveToFront(elem)
+
+	return elem.Value.(*lruItem).resp, true
+}
+
+func (c *lruCache) Set(_ context.Context, key string, resp CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, has := c.items[key]; has {
+		elem.Value.(*lruItem).resp = resp
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&lruItem{key: key, resp: resp})
+
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+func (c *lruCache) Delete(_ context.Context, key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, has := c.items[key]; has {
+		c.order.Remove(elem)
+		delete(c.items, key)
+	}
+}
+
+func newResponseCache(cache Cache, opts CacheOptions) *responseCache {
+	return &responseCache{cache: cache, opts: opts, now: time.Now, generations: map[string]uint64{}}
+}
+
+func (rc *responseCache) cacheable(path string) bool {
+	path = strings.TrimPrefix(path, "/")
+	for _, prefix := range rc.opts.SkipPaths {
+		if strings.HasPrefix(path, strings.TrimPrefix(prefix, "/")) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// key identifies response by url, generation of its path and headers (token, sudo etc.),
+// headers are hashed to keep token out of external caches
+func (rc *responseCache) key(req *http.Request) string {
+	hash := sha256.New()
+	_ = req.Header.Write(hash)
+
+	rc.mu.Lock()
+	generation := rc.generations[req.URL.EscapedPath()]
+	rc.mu.Unlock()
+
+	return hex.EncodeToString(hash.Sum(nil)[:8]) + " " + strconv.FormatUint(generation, 10) + " " + req.URL.String()
+}
+
+func (rc *responseCache) get(ctx context.Context, c *client, path string) ([]byte, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	key := rc.key(req)
+
+	cached, has := rc.cache.Get(ctx, key)
+	if has && rc.now().Before(cached.ExpiresAt) {
+		return copyBody(cached.Body), nil
+	}
+
+	if has && cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if has && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+
+		cached.ExpiresAt = rc.now().Add(rc.opts.TTL)
+		rc.cache.Set(ctx, key, cached)
+
+		return copyBody(cached.Body), nil
+	}
+
+	if err = checkStatus(resp); err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("can't read response body: %w", err)
+	}
+
+	etag := resp.Header.Get("ETag")
+	if etag != "" || rc.opts.TTL > 0 {
+		rc.cache.Set(ctx, key, CachedResponse{
+			Body:      copyBody(body),
+			ETag:      etag,
+			ExpiresAt: rc.now().Add(rc.opts.TTL),
+		})
+	}
+
+	return body, nil
+}
+
+// copyBody keeps cached body intact if caller modifies returned one
+func copyBody(body []byte) []byte {
+	return append([]byte(nil), body...)
+}
+
+// invalidate removes cached responses of the path modified by non GET request and of its parent collections
+// (e.g. "projects/10/hooks" and "projects/10" for "projects/10/hooks/1") requested with any query,
+// they are deleted from cache and generations of their paths are incremented
+func (rc *responseCache) invalidate(ctx context.Context, c *client, path string) {
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return
+	}
+
+	rc.cache.Delete(ctx, rc.key(req))
+
+	u := *req.URL
+	u.RawQuery = ""
+	for p := strings.TrimSuffix(req.URL.EscapedPath(), "/"); p != ""; p = p[:strings.LastIndex(p, "/")] {
+		if u.Path, err = url.PathUnescape(p); err != nil {
+			continue
+		}
+		u.RawPath = p
+
+		req.URL = &u
+		rc.cache.Delete(ctx, rc.key(req))
+
+		rc.mu.Lock()
+		rc.generations[p]++
+		rc.mu.Unlock()
+	}
+}
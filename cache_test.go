@@ -0,0 +1,281 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestLRUCache(t *testing.T) {
+	ctx := context.Background()
+	cache := gitlab.NewLRUCache(2)
+
+	cache.Set(ctx, "a", gitlab.CachedResponse{Body: []byte("a")})
+	cache.Set(ctx, "b", gitlab.CachedResponse{Body: []byte("b")})
+
+	// "a" becomes the most recently used, so "b" is evicted
+	resp, has := cache.Get(ctx, "a")
+	assert.True(t, has)
+	assert.Equal(t, []byte("a"), resp.Body)
+
+	cache.Set(ctx, "c", gitlab.CachedResponse{Body: []byte("c")})
+
+	_, has = cache.Get(ctx, "b")
+	assert.False(t, has)
+
+	_, has = cache.Get(ctx, "c")
+	assert.True(t, has)
+
+	cache.Delete(ctx, "a")
+	_, has = cache.Get(ctx, "a")
+	assert.False(t, has)
+}
+
+func TestClient_WithCache(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	t.Run("revalidation by etag", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, "", req.Header.Get("If-None-Match"))
+		}).Return(&http.Response{
+			Header:     http.Header{"Etag": []string{`W/"v1"`}},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 5, "name": "John"}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, `W/"v1"`, req.Header.Get("If-None-Match"))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusNotModified,
+		}, nil).Once()
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithCache(nil, gitlab.CacheOptions{}),
+		)
+
+		for i := 0; i < 2; i++ {
+			user, err := client.GetUserByID(context.Background(), 5)
+			assert.NoError(t, err)
+			assert.Equal(t, gitlab.User{ID: 5, Name: "John"}, user)
+		}
+
+		httpClient.AssertExpectations(t)
+	})
+
+	t.Run("fresh response", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 5}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithCache(gitlab.NewLRUCache(10), gitlab.CacheOptions{TTL: time.Hour}),
+		)
+
+		for i := 0; i < 3; i++ {
+			user, err := client.GetUserByID(context.Background(), 5)
+			assert.NoError(t, err)
+			assert.Equal(t, 5, user.ID)
+		}
+
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("modification of returned body", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 5}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithCache(nil, gitlab.CacheOptions{TTL: time.Hour}),
+		)
+
+		for i := 0; i < 3; i++ {
+			body, err := client.SendRequest(context.Background(), http.MethodGet, "users/5", nil)
+			assert.NoError(t, err)
+			assert.Equal(t, `{"id": 5}`, string(body))
+
+			copy(body, "corrupted")
+		}
+
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("skipped path", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(
+			func(req *http.Request) *http.Response {
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": "d1"}`))),
+					StatusCode: http.StatusOK,
+				}
+			},
+			nil,
+		)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithCache(nil, gitlab.CacheOptions{TTL: time.Hour, SkipPaths: []string{"projects/10/merge_requests"}}),
+		)
+
+		for i := 0; i < 2; i++ {
+			_, err := client.GetDiscussion(context.Background(), 10, 20, "d1")
+			assert.NoError(t, err)
+		}
+
+		httpClient.AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("responses are not shared between tokens", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(
+			func(req *http.Request) *http.Response {
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 5}`))),
+					StatusCode: http.StatusOK,
+				}
+			},
+			nil,
+		)
+
+		cache := gitlab.NewLRUCache(10)
+		for _, token := range []string{"first_token", "second_token", "first_token"} {
+			client := gitlab.NewClient(
+				token,
+				gitlab.WithBaseUrl(baseUrl),
+				gitlab.WithHttpClient(httpClient),
+				gitlab.WithCache(cache, gitlab.CacheOptions{TTL: time.Hour}),
+			)
+
+			_, err := client.GetUserByID(context.Background(), 5)
+			assert.NoError(t, err)
+		}
+
+		httpClient.AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("invalidation by modifying request", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(
+			func(req *http.Request) *http.Response {
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"name": "master"}`))),
+					StatusCode: http.StatusOK,
+				}
+			},
+			nil,
+		)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithCache(nil, gitlab.CacheOptions{TTL: time.Hour}),
+		)
+
+		_, err := client.ProtectedBranches().Get(context.Background(), 10, "master")
+		assert.NoError(t, err)
+
+		_, err = client.ProtectedBranches().Update(context.Background(), 10, "master", gitlab.UpdateProtectedBranchOptions{})
+		assert.NoError(t, err)
+
+		_, err = client.ProtectedBranches().Get(context.Background(), 10, "master")
+		assert.NoError(t, err)
+
+		httpClient.AssertNumberOfCalls(t, "Do", 3)
+	})
+
+	t.Run("list invalidation by creation and deletion", func(t *testing.T) {
+		var requests []string
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			requests = append(requests, req.Method+" "+req.URL.String())
+		}).Return(
+			func(req *http.Request) *http.Response {
+				body := `[{"id": 1}]`
+				if req.Method == http.MethodPost {
+					body = `{"id": 2}`
+				}
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+					StatusCode: http.StatusOK,
+				}
+			},
+			nil,
+		)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithCache(nil, gitlab.CacheOptions{TTL: time.Hour}),
+		)
+
+		_, err := client.Projects().ListHooks(context.Background(), 10, gitlab.ListOptions{})
+		assert.NoError(t, err)
+
+		_, err = client.Projects().AddHook(context.Background(), 10, gitlab.HookOptions{Url: "https://bot.test.com/hook"})
+		assert.NoError(t, err)
+
+		_, err = client.Projects().ListHooks(context.Background(), 10, gitlab.ListOptions{})
+		assert.NoError(t, err)
+
+		_, err = client.Projects().ListHooks(context.Background(), 10, gitlab.ListOptions{PerPage: 20})
+		assert.NoError(t, err)
+
+		assert.NoError(t, client.Projects().DeleteHook(context.Background(), 10, 2))
+
+		for i := 0; i < 2; i++ {
+			_, err = client.Projects().ListHooks(context.Background(), 10, gitlab.ListOptions{})
+			assert.NoError(t, err)
+
+			_, err = client.Projects().ListHooks(context.Background(), 10, gitlab.ListOptions{PerPage: 20})
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, []string{
+			"GET " + baseUrl + "/projects/10/hooks",
+			"POST " + baseUrl + "/projects/10/hooks",
+			"GET " + baseUrl + "/projects/10/hooks",
+			"GET " + baseUrl + "/projects/10/hooks?per_page=20",
+			"DELETE " + baseUrl + "/projects/10/hooks/2",
+			"GET " + baseUrl + "/projects/10/hooks",
+			"GET " + baseUrl + "/projects/10/hooks?per_page=20",
+		}, requests)
+	})
+}
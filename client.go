@@ -1,19 +1,35 @@
 // Package gitlab - client
 package gitlab
 
-//go:generate mockery -case=underscore -inpkg -name=Client
-//go:generate mockery -case=underscore -inpkg -name=HTTPClient
+//go:generate go run ./internal/mockgen -source client.go -name Client -output mock_client.go
+//go:generate go run ./internal/mockgen -source client.go -name HTTPClient -output mock_http_client.go
+//go:generate go run ./internal/mockgen -source services.go -name Users -output mock_users.go
+//go:generate go run ./internal/mockgen -source services.go -name Discussions -output mock_discussions.go
+//go:generate go run ./internal/mockgen -source services.go -name MergeRequests -output mock_merge_requests.go
+//go:generate go run ./internal/mockgen -source services.go -name Projects -output mock_projects.go
+//go:generate go run ./internal/mockgen -source services.go -name Groups -output mock_groups.go
+//go:generate go run ./internal/mockgen -source services.go -name SystemHooks -output mock_system_hooks.go
+//go:generate go run ./internal/mockgen -source services.go -name Branches -output mock_branches.go
+//go:generate go run ./internal/mockgen -source services.go -name Tags -output mock_tags.go
+//go:generate go run ./internal/mockgen -source services.go -name ProtectedBranches -output mock_protected_branches.go
+//go:generate go run ./internal/mockgen -source services.go -name ProtectedTags -output mock_protected_tags.go
+//go:generate go run ./internal/mockgen -source services.go -name Repository -output mock_repository.go
+//go:generate go run ./internal/mockgen -source services.go -name AwardEmojis -output mock_award_emojis.go
+//go:generate go run ./internal/mockgen -source services.go -name Instance -output mock_instance.go
 
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"runtime"
+	"time"
 )
 
-const defaultBaseUrl = "http://gitlab.com/api/v4"
+const defaultBaseUrl = "https://gitlab.com/api/v4"
 
 var defaultConcurrency = runtime.NumCPU()
 
@@ -26,26 +42,85 @@ type (
 	// Client provides api to work with gitlab entities
 	Client interface {
 		// GetUsersByIDs returns list of users by ids
-		GetUsersByIDs(ctx context.Context, ids []int) ([]User, error)
+		GetUsersByIDs(ctx context.Context, ids []int, reqOpts ...RequestOption) ([]User, error)
 
 		// GetUserByID returns single user by id
-		GetUserByID(ctx context.Context, userID int) (User, error)
+		GetUserByID(ctx context.Context, userID int, reqOpts ...RequestOption) (User, error)
 
 		// GetDiscussion returns discussion data by project id, merge request id and discussion id
-		GetDiscussion(ctx context.Context, projectID, mrID int, discussionID string) (Discussion, error)
+		GetDiscussion(
+			ctx context.Context,
+			projectID, mrID int,
+			discussionID string,
+			reqOpts ...RequestOption,
+		) (Discussion, error)
 
 		// GetParticipants returns all participants from discussion (by project id, merge request id and discussion id)
-		GetParticipants(ctx context.Context, projectID, mrID int, discussionID string) ([]NoteAuthor, error)
+		GetParticipants(
+			ctx context.Context,
+			projectID, mrID int,
+			discussionID string,
+			reqOpts ...RequestOption,
+		) ([]NoteAuthor, error)
+
+		// Users returns api to work with users
+		Users() Users
+
+		// Discussions returns api to work with merge request discussions
+		Discussions() Discussions
+
+		// MergeRequests returns api to work with merge requests, their diffs and approvals
+		MergeRequests() MergeRequests
+
+		// Projects returns api to work with project approval rules and hooks
+		Projects() Projects
+
+		// Groups returns api to work with group hooks
+		Groups() Groups
+
+		// SystemHooks returns api to work with system hooks
+		SystemHooks() SystemHooks
+
+		// Branches returns api to work with repository branches
+		Branches() Branches
+
+		// Tags returns api to work with repository tags
+		Tags() Tags
+
+		// ProtectedBranches returns api to work with protected branches
+		ProtectedBranches() ProtectedBranches
+
+		// ProtectedTags returns api to work with protected tags
+		ProtectedTags() ProtectedTags
+
+		// Repository returns api to work with repository tree, comparisons, contributors and archives
+		Repository() Repository
+
+		// AwardEmojis returns api to work with award emoji of merge requests, issues, snippets and notes
+		AwardEmojis() AwardEmojis
+
+		// Instance returns api to get version, metadata and features of gitlab instance
+		Instance() Instance
 
 		// SendRequest send http request to gitlab
-		SendRequest(ctx context.Context, method string, path string, data []byte) ([]byte, error)
+		SendRequest(ctx context.Context, method string, path string, data []byte, reqOpts ...RequestOption) ([]byte, error)
 	}
 
 	client struct {
 		token       string
+		tokenHeader string
 		baseUrl     string
 		concurrency int
+		timeout     time.Duration
 		httpClient  HTTPClient
+		transport   transportConfig
+		cache       *responseCache
+		middlewares []Middleware
+		roundTrip   RoundTripFunc
+		version     versionCache
+		logger      *requestLogger
+		metrics     MetricsCollector
+		tracer      Tracer
 	}
 )
 
@@ -54,42 +129,1168 @@ func NewClient(token string, opts ...ClientOption) Client {
 	c := &client{
 		token:       token,
 		baseUrl:     defaultBaseUrl,
+		tokenHeader: privateTokenKey,
 		concurrency: defaultConcurrency,
-		httpClient:  &http.Client{},
+		transport:   newTransportConfig(),
 	}
 
 	for _, opt := range opts {
 		opt.apply(c)
 	}
 
+	if c.httpClient == nil {
+		c.httpClient = c.transport.httpClient(c.concurrency)
+	} else {
+		// transport options are ignored with custom http client, so are their errors
+		c.transport.err = nil
+	}
+
+	c.roundTrip = chainMiddlewares(c.attempt, c.middlewares)
+
 	return c
 }
 
 // GetParticipants implementation
-func (c *client) GetParticipants(ctx context.Context, projectID, mrID int, discussionID string) ([]NoteAuthor, error) {
+func (c *client) GetParticipants(
+	ctx context.Context,
+	projectID, mrID int,
+	discussionID string,
+	reqOpts ...RequestOption,
+) (_ []NoteAuthor, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetParticipants", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
 	return getParticipants(ctx, c, projectID, mrID, discussionID)
 }
 
 // GetDiscussion implementation
-func (c *client) GetDiscussion(ctx context.Context, projectID, mrID int, discussionID string) (Discussion, error) {
+func (c *client) GetDiscussion(
+	ctx context.Context,
+	projectID, mrID int,
+	discussionID string,
+	reqOpts ...RequestOption,
+) (_ Discussion, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetDiscussion", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
 	return getDiscussion(ctx, c, projectID, mrID, discussionID)
 }
 
 // GetUsersByIDs implementation
-func (c *client) GetUsersByIDs(ctx context.Context, ids []int) ([]User, error) {
+func (c *client) GetUsersByIDs(ctx context.Context, ids []int, reqOpts ...RequestOption) (_ []User, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetUsersByIDs")
+	defer span.end(&err)
+
 	return getUsersByIDs(ctx, c, ids)
 }
 
 // GetUserByID implementation
-func (c *client) GetUserByID(ctx context.Context, id int) (User, error) {
+func (c *client) GetUserByID(ctx context.Context, id int, reqOpts ...RequestOption) (_ User, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetUserByID", userAttr(id))
+	defer span.end(&err)
+
 	return getUserByID(ctx, c, id)
 }
 
+// ListBranches implementation
+func (c *client) ListBranches(
+	ctx context.Context,
+	projectID int,
+	opts ListBranchesOptions,
+	reqOpts ...RequestOption,
+) (_ []Branch, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListBranches", projectAttr(projectID))
+	defer span.end(&err)
+
+	return listBranches(ctx, c, projectID, opts)
+}
+
+// GetBranch implementation
+func (c *client) GetBranch(
+	ctx context.Context,
+	projectID int,
+	branch string,
+	reqOpts ...RequestOption,
+) (_ Branch, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetBranch", projectAttr(projectID))
+	defer span.end(&err)
+
+	return getBranch(ctx, c, projectID, branch)
+}
+
+// CreateBranch implementation
+func (c *client) CreateBranch(
+	ctx context.Context,
+	projectID int,
+	branch, ref string,
+	reqOpts ...RequestOption,
+) (_ Branch, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "CreateBranch", projectAttr(projectID))
+	defer span.end(&err)
+
+	return createBranch(ctx, c, projectID, branch, ref)
+}
+
+// DeleteBranch implementation
+func (c *client) DeleteBranch(ctx context.Context, projectID int, branch string, reqOpts ...RequestOption) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "DeleteBranch", projectAttr(projectID))
+	defer span.end(&err)
+
+	return deleteBranch(ctx, c, projectID, branch)
+}
+
+// DeleteMergedBranches implementation
+func (c *client) DeleteMergedBranches(ctx context.Context, projectID int, reqOpts ...RequestOption) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "DeleteMergedBranches", projectAttr(projectID))
+	defer span.end(&err)
+
+	return deleteMergedBranches(ctx, c, projectID)
+}
+
+// ListTags implementation
+func (c *client) ListTags(
+	ctx context.Context,
+	projectID int,
+	opts ListTagsOptions,
+	reqOpts ...RequestOption,
+) (_ []Tag, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListTags", projectAttr(projectID))
+	defer span.end(&err)
+
+	return listTags(ctx, c, projectID, opts)
+}
+
+// GetTag implementation
+func (c *client) GetTag(ctx context.Context, projectID int, tag string, reqOpts ...RequestOption) (_ Tag, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetTag", projectAttr(projectID))
+	defer span.end(&err)
+
+	return getTag(ctx, c, projectID, tag)
+}
+
+// CreateTag implementation
+func (c *client) CreateTag(
+	ctx context.Context,
+	projectID int,
+	opts CreateTagOptions,
+	reqOpts ...RequestOption,
+) (_ Tag, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "CreateTag", projectAttr(projectID))
+	defer span.end(&err)
+
+	return createTag(ctx, c, projectID, opts)
+}
+
+// DeleteTag implementation
+func (c *client) DeleteTag(ctx context.Context, projectID int, tag string, reqOpts ...RequestOption) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "DeleteTag", projectAttr(projectID))
+	defer span.end(&err)
+
+	return deleteTag(ctx, c, projectID, tag)
+}
+
+// ListProtectedBranches implementation
+func (c *client) ListProtectedBranches(
+	ctx context.Context,
+	projectID int,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) (_ []ProtectedBranch, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListProtectedBranches", projectAttr(projectID))
+	defer span.end(&err)
+
+	return listProtectedBranches(ctx, c, projectID, opts)
+}
+
+// GetProtectedBranch implementation
+func (c *client) GetProtectedBranch(
+	ctx context.Context,
+	projectID int,
+	name string,
+	reqOpts ...RequestOption,
+) (_ ProtectedBranch, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetProtectedBranch", projectAttr(projectID))
+	defer span.end(&err)
+
+	return getProtectedBranch(ctx, c, projectID, name)
+}
+
+// ProtectBranch implementation
+func (c *client) ProtectBranch(
+	ctx context.Context,
+	projectID int,
+	opts ProtectBranchOptions,
+	reqOpts ...RequestOption,
+) (_ ProtectedBranch, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ProtectBranch", projectAttr(projectID))
+	defer span.end(&err)
+
+	return protectBranch(ctx, c, projectID, opts)
+}
+
+// UpdateProtectedBranch implementation
+func (c *client) UpdateProtectedBranch(
+	ctx context.Context,
+	projectID int,
+	name string,
+	opts UpdateProtectedBranchOptions,
+	reqOpts ...RequestOption,
+) (_ ProtectedBranch, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "UpdateProtectedBranch", projectAttr(projectID))
+	defer span.end(&err)
+
+	return updateProtectedBranch(ctx, c, projectID, name, opts)
+}
+
+// UnprotectBranch implementation
+func (c *client) UnprotectBranch(
+	ctx context.Context,
+	projectID int,
+	name string,
+	reqOpts ...RequestOption,
+) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "UnprotectBranch", projectAttr(projectID))
+	defer span.end(&err)
+
+	return unprotectBranch(ctx, c, projectID, name)
+}
+
+// ListProtectedTags implementation
+func (c *client) ListProtectedTags(
+	ctx context.Context,
+	projectID int,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) (_ []ProtectedTag, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListProtectedTags", projectAttr(projectID))
+	defer span.end(&err)
+
+	return listProtectedTags(ctx, c, projectID, opts)
+}
+
+// GetProtectedTag implementation
+func (c *client) GetProtectedTag(
+	ctx context.Context,
+	projectID int,
+	name string,
+	reqOpts ...RequestOption,
+) (_ ProtectedTag, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetProtectedTag", projectAttr(projectID))
+	defer span.end(&err)
+
+	return getProtectedTag(ctx, c, projectID, name)
+}
+
+// ProtectTag implementation
+func (c *client) ProtectTag(
+	ctx context.Context,
+	projectID int,
+	opts ProtectTagOptions,
+	reqOpts ...RequestOption,
+) (_ ProtectedTag, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ProtectTag", projectAttr(projectID))
+	defer span.end(&err)
+
+	return protectTag(ctx, c, projectID, opts)
+}
+
+// UnprotectTag implementation
+func (c *client) UnprotectTag(ctx context.Context, projectID int, name string, reqOpts ...RequestOption) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "UnprotectTag", projectAttr(projectID))
+	defer span.end(&err)
+
+	return unprotectTag(ctx, c, projectID, name)
+}
+
+// ListTree implementation
+func (c *client) ListTree(
+	ctx context.Context,
+	projectID int,
+	opts ListTreeOptions,
+	reqOpts ...RequestOption,
+) (_ []TreeNode, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListTree", projectAttr(projectID))
+	defer span.end(&err)
+
+	return listTree(ctx, c, projectID, opts)
+}
+
+// Compare implementation
+func (c *client) Compare(
+	ctx context.Context,
+	projectID int,
+	from, to string,
+	reqOpts ...RequestOption,
+) (_ Comparison, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "Compare", projectAttr(projectID))
+	defer span.end(&err)
+
+	return compare(ctx, c, projectID, from, to)
+}
+
+// ListContributors implementation
+func (c *client) ListContributors(
+	ctx context.Context,
+	projectID int,
+	opts ListContributorsOptions,
+	reqOpts ...RequestOption,
+) (_ []Contributor, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListContributors", projectAttr(projectID))
+	defer span.end(&err)
+
+	return listContributors(ctx, c, projectID, opts)
+}
+
+// GetArchive implementation
+func (c *client) GetArchive(
+	ctx context.Context,
+	projectID int,
+	w io.Writer,
+	opts ArchiveOptions,
+	reqOpts ...RequestOption,
+) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetArchive", projectAttr(projectID))
+	defer span.end(&err)
+
+	return getArchive(ctx, c, projectID, w, opts)
+}
+
+// GetMergeRequestChanges implementation
+func (c *client) GetMergeRequestChanges(
+	ctx context.Context,
+	projectID, mrID int,
+	reqOpts ...RequestOption,
+) (_ MergeRequestChanges, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetMergeRequestChanges", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return getMergeRequestChanges(ctx, c, projectID, mrID)
+}
+
+// ListMergeRequestDiffs implementation
+func (c *client) ListMergeRequestDiffs(
+	ctx context.Context,
+	projectID, mrID int,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) (_ []Diff, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListMergeRequestDiffs", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return listMergeRequestDiffs(ctx, c, projectID, mrID, opts)
+}
+
+// ListMergeRequestDiffVersions implementation
+func (c *client) ListMergeRequestDiffVersions(
+	ctx context.Context,
+	projectID, mrID int,
+	reqOpts ...RequestOption,
+) (_ []MergeRequestDiffVersion, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListMergeRequestDiffVersions", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return listMergeRequestDiffVersions(ctx, c, projectID, mrID)
+}
+
+// GetMergeRequestDiffVersion implementation
+func (c *client) GetMergeRequestDiffVersion(
+	ctx context.Context,
+	projectID, mrID, versionID int,
+	reqOpts ...RequestOption,
+) (_ MergeRequestDiffVersion, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetMergeRequestDiffVersion", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return getMergeRequestDiffVersion(ctx, c, projectID, mrID, versionID)
+}
+
+// BuildPosition implementation
+func (c *client) BuildPosition(
+	ctx context.Context,
+	projectID, mrID int,
+	opts BuildPositionOptions,
+	reqOpts ...RequestOption,
+) (_ Position, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "BuildPosition", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return buildPosition(ctx, c, projectID, mrID, opts)
+}
+
+// BuildImagePosition implementation
+func (c *client) BuildImagePosition(
+	ctx context.Context,
+	projectID, mrID int,
+	opts BuildImagePositionOptions,
+	reqOpts ...RequestOption,
+) (_ Position, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "BuildImagePosition", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return buildImagePosition(ctx, c, projectID, mrID, opts)
+}
+
+// ApproveMergeRequest implementation
+func (c *client) ApproveMergeRequest(
+	ctx context.Context,
+	projectID, mrID int,
+	sha string,
+	reqOpts ...RequestOption,
+) (_ MergeRequestApprovals, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ApproveMergeRequest", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return approveMergeRequest(ctx, c, projectID, mrID, sha)
+}
+
+// UnapproveMergeRequest implementation
+func (c *client) UnapproveMergeRequest(ctx context.Context, projectID, mrID int, reqOpts ...RequestOption) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "UnapproveMergeRequest", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return unapproveMergeRequest(ctx, c, projectID, mrID)
+}
+
+// GetMergeRequestApprovals implementation
+func (c *client) GetMergeRequestApprovals(
+	ctx context.Context,
+	projectID, mrID int,
+	reqOpts ...RequestOption,
+) (_ MergeRequestApprovals, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetMergeRequestApprovals", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return getMergeRequestApprovals(ctx, c, projectID, mrID)
+}
+
+// GetMergeRequestApprovalState implementation
+func (c *client) GetMergeRequestApprovalState(
+	ctx context.Context,
+	projectID, mrID int,
+	reqOpts ...RequestOption,
+) (_ ApprovalState, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetMergeRequestApprovalState", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return getMergeRequestApprovalState(ctx, c, projectID, mrID)
+}
+
+// ListProjectApprovalRules implementation
+func (c *client) ListProjectApprovalRules(
+	ctx context.Context,
+	projectID int,
+	reqOpts ...RequestOption,
+) (_ []ApprovalRule, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListProjectApprovalRules", projectAttr(projectID))
+	defer span.end(&err)
+
+	return listProjectApprovalRules(ctx, c, projectID)
+}
+
+// CreateProjectApprovalRule implementation
+func (c *client) CreateProjectApprovalRule(
+	ctx context.Context,
+	projectID int,
+	opts ApprovalRuleOptions,
+	reqOpts ...RequestOption,
+) (_ ApprovalRule, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "CreateProjectApprovalRule", projectAttr(projectID))
+	defer span.end(&err)
+
+	return createProjectApprovalRule(ctx, c, projectID, opts)
+}
+
+// UpdateProjectApprovalRule implementation
+func (c *client) UpdateProjectApprovalRule(
+	ctx context.Context,
+	projectID, ruleID int,
+	opts UpdateApprovalRuleOptions,
+	reqOpts ...RequestOption,
+) (_ ApprovalRule, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "UpdateProjectApprovalRule", projectAttr(projectID))
+	defer span.end(&err)
+
+	return updateProjectApprovalRule(ctx, c, projectID, ruleID, opts)
+}
+
+// DeleteProjectApprovalRule implementation
+func (c *client) DeleteProjectApprovalRule(
+	ctx context.Context,
+	projectID, ruleID int,
+	reqOpts ...RequestOption,
+) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "DeleteProjectApprovalRule", projectAttr(projectID))
+	defer span.end(&err)
+
+	return deleteProjectApprovalRule(ctx, c, projectID, ruleID)
+}
+
+// ListMergeRequestApprovalRules implementation
+func (c *client) ListMergeRequestApprovalRules(
+	ctx context.Context,
+	projectID, mrID int,
+	reqOpts ...RequestOption,
+) (_ []ApprovalRule, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListMergeRequestApprovalRules", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return listMergeRequestApprovalRules(ctx, c, projectID, mrID)
+}
+
+// CreateMergeRequestApprovalRule implementation
+func (c *client) CreateMergeRequestApprovalRule(
+	ctx context.Context,
+	projectID, mrID int,
+	opts ApprovalRuleOptions,
+	reqOpts ...RequestOption,
+) (_ ApprovalRule, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "CreateMergeRequestApprovalRule", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return createMergeRequestApprovalRule(ctx, c, projectID, mrID, opts)
+}
+
+// UpdateMergeRequestApprovalRule implementation
+func (c *client) UpdateMergeRequestApprovalRule(
+	ctx context.Context,
+	projectID, mrID, ruleID int,
+	opts UpdateApprovalRuleOptions,
+	reqOpts ...RequestOption,
+) (_ ApprovalRule, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "UpdateMergeRequestApprovalRule", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return updateMergeRequestApprovalRule(ctx, c, projectID, mrID, ruleID, opts)
+}
+
+// DeleteMergeRequestApprovalRule implementation
+func (c *client) DeleteMergeRequestApprovalRule(
+	ctx context.Context,
+	projectID, mrID, ruleID int,
+	reqOpts ...RequestOption,
+) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "DeleteMergeRequestApprovalRule", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return deleteMergeRequestApprovalRule(ctx, c, projectID, mrID, ruleID)
+}
+
+// ListDiscussions implementation
+func (c *client) ListDiscussions(
+	ctx context.Context,
+	projectID, mrID int,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) (_ []Discussion, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListDiscussions", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return listDiscussions(ctx, c, projectID, mrID, opts)
+}
+
+// ListMergeRequests implementation
+func (c *client) ListMergeRequests(
+	ctx context.Context,
+	projectID int,
+	opts ListMergeRequestsOptions,
+	reqOpts ...RequestOption,
+) (_ []MergeRequest, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListMergeRequests", projectAttr(projectID))
+	defer span.end(&err)
+
+	return listMergeRequests(ctx, c, projectID, opts)
+}
+
+// GetMergeRequest implementation
+func (c *client) GetMergeRequest(
+	ctx context.Context,
+	projectID, mrID int,
+	reqOpts ...RequestOption,
+) (_ MergeRequest, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetMergeRequest", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return getMergeRequest(ctx, c, projectID, mrID)
+}
+
+// CreateMergeRequest implementation
+func (c *client) CreateMergeRequest(
+	ctx context.Context,
+	projectID int,
+	opts CreateMergeRequestOptions,
+	reqOpts ...RequestOption,
+) (_ MergeRequest, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "CreateMergeRequest", projectAttr(projectID))
+	defer span.end(&err)
+
+	return createMergeRequest(ctx, c, projectID, opts)
+}
+
+// UpdateMergeRequest implementation
+func (c *client) UpdateMergeRequest(
+	ctx context.Context,
+	projectID, mrID int,
+	opts UpdateMergeRequestOptions,
+	reqOpts ...RequestOption,
+) (_ MergeRequest, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "UpdateMergeRequest", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return updateMergeRequest(ctx, c, projectID, mrID, opts)
+}
+
+// GetMergeRequestParticipants implementation
+func (c *client) GetMergeRequestParticipants(
+	ctx context.Context,
+	projectID, mrID int,
+	opts ParticipantsReportOptions,
+	reqOpts ...RequestOption,
+) (_ []Participant, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetMergeRequestParticipants", projectAttr(projectID), mergeRequestAttr(mrID))
+	defer span.end(&err)
+
+	return getMergeRequestParticipants(ctx, c, projectID, mrID, opts)
+}
+
+// ListAwardEmoji implementation
+func (c *client) ListAwardEmoji(
+	ctx context.Context,
+	awardable Awardable,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) (_ []AwardEmoji, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListAwardEmoji")
+	defer span.end(&err)
+
+	return listAwardEmoji(ctx, c, awardable, opts)
+}
+
+// AddAwardEmoji implementation
+func (c *client) AddAwardEmoji(
+	ctx context.Context,
+	awardable Awardable,
+	name string,
+	reqOpts ...RequestOption,
+) (_ AwardEmoji, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "AddAwardEmoji")
+	defer span.end(&err)
+
+	return addAwardEmoji(ctx, c, awardable, name)
+}
+
+// RemoveAwardEmoji implementation
+func (c *client) RemoveAwardEmoji(
+	ctx context.Context,
+	awardable Awardable,
+	awardID int,
+	reqOpts ...RequestOption,
+) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "RemoveAwardEmoji")
+	defer span.end(&err)
+
+	return removeAwardEmoji(ctx, c, awardable, awardID)
+}
+
+// ListProjectHooks implementation
+func (c *client) ListProjectHooks(
+	ctx context.Context,
+	projectID int,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) (_ []Hook, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListProjectHooks", projectAttr(projectID))
+	defer span.end(&err)
+
+	return listHooks(ctx, c, projectHooksPath(projectID), opts)
+}
+
+// GetProjectHook implementation
+func (c *client) GetProjectHook(
+	ctx context.Context,
+	projectID, hookID int,
+	reqOpts ...RequestOption,
+) (_ Hook, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetProjectHook", projectAttr(projectID))
+	defer span.end(&err)
+
+	return getHook(ctx, c, projectHooksPath(projectID), hookID)
+}
+
+// AddProjectHook implementation
+func (c *client) AddProjectHook(
+	ctx context.Context,
+	projectID int,
+	opts HookOptions,
+	reqOpts ...RequestOption,
+) (_ Hook, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "AddProjectHook", projectAttr(projectID))
+	defer span.end(&err)
+
+	return addHook(ctx, c, projectHooksPath(projectID), opts)
+}
+
+// EditProjectHook implementation
+func (c *client) EditProjectHook(
+	ctx context.Context,
+	projectID, hookID int,
+	opts HookOptions,
+	reqOpts ...RequestOption,
+) (_ Hook, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "EditProjectHook", projectAttr(projectID))
+	defer span.end(&err)
+
+	return editHook(ctx, c, projectHooksPath(projectID), hookID, opts)
+}
+
+// DeleteProjectHook implementation
+func (c *client) DeleteProjectHook(ctx context.Context, projectID, hookID int, reqOpts ...RequestOption) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "DeleteProjectHook", projectAttr(projectID))
+	defer span.end(&err)
+
+	return deleteHook(ctx, c, projectHooksPath(projectID), hookID)
+}
+
+// TestProjectHook implementation
+func (c *client) TestProjectHook(
+	ctx context.Context,
+	projectID, hookID int,
+	trigger HookTrigger,
+	reqOpts ...RequestOption,
+) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "TestProjectHook", projectAttr(projectID))
+	defer span.end(&err)
+
+	return testHook(ctx, c, projectHooksPath(projectID), hookID, trigger)
+}
+
+// SetProjectHookUrlVariable implementation
+func (c *client) SetProjectHookUrlVariable(
+	ctx context.Context,
+	projectID, hookID int,
+	key, value string,
+	reqOpts ...RequestOption,
+) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "SetProjectHookUrlVariable", projectAttr(projectID))
+	defer span.end(&err)
+
+	return setHookUrlVariable(ctx, c, projectHooksPath(projectID), hookID, key, value)
+}
+
+// DeleteProjectHookUrlVariable implementation
+func (c *client) DeleteProjectHookUrlVariable(
+	ctx context.Context,
+	projectID, hookID int,
+	key string,
+	reqOpts ...RequestOption,
+) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "DeleteProjectHookUrlVariable", projectAttr(projectID))
+	defer span.end(&err)
+
+	return deleteHookUrlVariable(ctx, c, projectHooksPath(projectID), hookID, key)
+}
+
+// ListGroupHooks implementation
+func (c *client) ListGroupHooks(
+	ctx context.Context,
+	groupID int,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) (_ []Hook, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListGroupHooks", groupAttr(groupID))
+	defer span.end(&err)
+
+	return listHooks(ctx, c, groupHooksPath(groupID), opts)
+}
+
+// GetGroupHook implementation
+func (c *client) GetGroupHook(ctx context.Context, groupID, hookID int, reqOpts ...RequestOption) (_ Hook, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetGroupHook", groupAttr(groupID))
+	defer span.end(&err)
+
+	return getHook(ctx, c, groupHooksPath(groupID), hookID)
+}
+
+// AddGroupHook implementation
+func (c *client) AddGroupHook(
+	ctx context.Context,
+	groupID int,
+	opts HookOptions,
+	reqOpts ...RequestOption,
+) (_ Hook, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "AddGroupHook", groupAttr(groupID))
+	defer span.end(&err)
+
+	return addHook(ctx, c, groupHooksPath(groupID), opts)
+}
+
+// EditGroupHook implementation
+func (c *client) EditGroupHook(
+	ctx context.Context,
+	groupID, hookID int,
+	opts HookOptions,
+	reqOpts ...RequestOption,
+) (_ Hook, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "EditGroupHook", groupAttr(groupID))
+	defer span.end(&err)
+
+	return editHook(ctx, c, groupHooksPath(groupID), hookID, opts)
+}
+
+// DeleteGroupHook implementation
+func (c *client) DeleteGroupHook(ctx context.Context, groupID, hookID int, reqOpts ...RequestOption) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "DeleteGroupHook", groupAttr(groupID))
+	defer span.end(&err)
+
+	return deleteHook(ctx, c, groupHooksPath(groupID), hookID)
+}
+
+// TestGroupHook implementation
+func (c *client) TestGroupHook(
+	ctx context.Context,
+	groupID, hookID int,
+	trigger HookTrigger,
+	reqOpts ...RequestOption,
+) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "TestGroupHook", groupAttr(groupID))
+	defer span.end(&err)
+
+	return testHook(ctx, c, groupHooksPath(groupID), hookID, trigger)
+}
+
+// SetGroupHookUrlVariable implementation
+func (c *client) SetGroupHookUrlVariable(
+	ctx context.Context,
+	groupID, hookID int,
+	key, value string,
+	reqOpts ...RequestOption,
+) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "SetGroupHookUrlVariable", groupAttr(groupID))
+	defer span.end(&err)
+
+	return setHookUrlVariable(ctx, c, groupHooksPath(groupID), hookID, key, value)
+}
+
+// DeleteGroupHookUrlVariable implementation
+func (c *client) DeleteGroupHookUrlVariable(
+	ctx context.Context,
+	groupID, hookID int,
+	key string,
+	reqOpts ...RequestOption,
+) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "DeleteGroupHookUrlVariable", groupAttr(groupID))
+	defer span.end(&err)
+
+	return deleteHookUrlVariable(ctx, c, groupHooksPath(groupID), hookID, key)
+}
+
+// ListSystemHooks implementation
+func (c *client) ListSystemHooks(
+	ctx context.Context,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) (_ []SystemHook, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "ListSystemHooks")
+	defer span.end(&err)
+
+	return listSystemHooks(ctx, c, opts)
+}
+
+// AddSystemHook implementation
+func (c *client) AddSystemHook(
+	ctx context.Context,
+	opts SystemHookOptions,
+	reqOpts ...RequestOption,
+) (_ SystemHook, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "AddSystemHook")
+	defer span.end(&err)
+
+	return addSystemHook(ctx, c, opts)
+}
+
+// TestSystemHook implementation
+func (c *client) TestSystemHook(ctx context.Context, hookID int, reqOpts ...RequestOption) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "TestSystemHook")
+	defer span.end(&err)
+
+	return testSystemHook(ctx, c, hookID)
+}
+
+// DeleteSystemHook implementation
+func (c *client) DeleteSystemHook(ctx context.Context, hookID int, reqOpts ...RequestOption) (err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "DeleteSystemHook")
+	defer span.end(&err)
+
+	return deleteSystemHook(ctx, c, hookID)
+}
+
+// GetVersion implementation
+func (c *client) GetVersion(ctx context.Context, reqOpts ...RequestOption) (_ Version, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetVersion")
+	defer span.end(&err)
+
+	return getVersion(ctx, c)
+}
+
+// GetMetadata implementation
+func (c *client) GetMetadata(ctx context.Context, reqOpts ...RequestOption) (_ Metadata, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "GetMetadata")
+	defer span.end(&err)
+
+	return getMetadata(ctx, c)
+}
+
+// SupportsFeature implementation
+func (c *client) SupportsFeature(ctx context.Context, feature Feature, reqOpts ...RequestOption) (_ bool, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "SupportsFeature")
+	defer span.end(&err)
+
+	return supportsFeature(ctx, c, feature)
+}
+
 func (c *client) get(ctx context.Context, path string) ([]byte, error) {
-	return c.SendRequest(ctx, http.MethodGet, path, nil)
+	return c.request(ctx, http.MethodGet, path, nil)
+}
+
+func (c *client) post(ctx context.Context, path string, data interface{}) ([]byte, error) {
+	return c.sendJSON(ctx, http.MethodPost, path, data)
+}
+
+func (c *client) put(ctx context.Context, path string, data interface{}) ([]byte, error) {
+	return c.sendJSON(ctx, http.MethodPut, path, data)
+}
+
+func (c *client) patch(ctx context.Context, path string, data interface{}) ([]byte, error) {
+	return c.sendJSON(ctx, http.MethodPatch, path, data)
+}
+
+func (c *client) delete(ctx context.Context, path string) error {
+	_, err := c.request(ctx, http.MethodDelete, path, nil)
+	return err
+}
+
+func (c *client) sendJSON(ctx context.Context, method string, path string, data interface{}) ([]byte, error) {
+	var body []byte
+	if data != nil {
+		var err error
+		if body, err = json.Marshal(data); err != nil {
+			return nil, fmt.Errorf("can't marshal request data: %w", err)
+		}
+	}
+
+	return c.request(ctx, method, path, body)
+}
+
+// SendRequest implementation
+func (c *client) SendRequest(
+	ctx context.Context,
+	method string,
+	path string,
+	data []byte,
+	reqOpts ...RequestOption,
+) (_ []byte, err error) {
+	ctx = withRequestOptions(ctx, reqOpts)
+	ctx, span := c.startSpan(ctx, "SendRequest", methodAttr(method), targetAttr(path))
+	defer span.end(&err)
+
+	return c.request(ctx, method, path, data)
+}
+
+// request sends request of client method which is traced by span of the method
+func (c *client) request(ctx context.Context, method string, path string, data []byte) ([]byte, error) {
+	var body []byte
+	err := c.instrument(ctx, method, path, data, func(ctx context.Context) (int, error) {
+		var err error
+		body, err = c.sendRequest(ctx, method, path, data)
+
+		return len(body), err
+	})
+
+	return body, err
+}
+
+// stream is a streaming variant of SendRequest, it copies response body to w instead of reading it in memory
+func (c *client) stream(ctx context.Context, method string, path string, w io.Writer) error {
+	return c.instrument(ctx, method, path, nil, func(ctx context.Context) (int, error) {
+		resp, err := c.do(ctx, method, path, nil)
+		if err != nil {
+			return 0, err
+		}
+		defer resp.Body.Close()
+
+		n, err := io.Copy(w, resp.Body)
+		if err != nil {
+			return int(n), fmt.Errorf("can't copy response body: %w", err)
+		}
+
+		return int(n), nil
+	})
+}
+
+// instrument applies overall timeout to request sent by send and reports it to logger and metrics,
+// send returns size of response body
+func (c *client) instrument(
+	ctx context.Context,
+	method string,
+	path string,
+	data []byte,
+	send func(ctx context.Context) (int, error),
+) error {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	ctx = c.withAttemptCounter(ctx)
+
+	if c.logger == nil && c.metrics == nil {
+		_, err := send(ctx)
+		return err
+	}
+
+	ex := newExchange(method, path, data)
+	size, err := send(withExchange(ctx, ex))
+
+	if c.logger != nil {
+		c.logger.log(ctx, ex, err)
+	}
+
+	if c.metrics != nil {
+		c.metrics.ObserveRequest(ctx, ex.metrics(size, err))
+	}
+
+	return err
+}
+
+func (c *client) sendRequest(ctx context.Context, method string, path string, data []byte) ([]byte, error) {
+	if c.cache != nil && method == http.MethodGet && c.cache.cacheable(path) {
+		return c.cache.get(ctx, c, path)
+	}
+
+	resp, err := c.do(ctx, method, path, data)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var body []byte
+	if body, err = ioutil.ReadAll(resp.Body); nil != err {
+		return nil, fmt.Errorf("can't read response body: %w", err)
+	}
+
+	if c.cache != nil && method != http.MethodGet {
+		c.cache.invalidate(ctx, c, path)
+	}
+
+	return body, nil
+}
+
+// withTimeout limits context by overall timeout of request including all attempts made by middlewares
+func (c *client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, c.timeout)
+}
+
+// do sends http request and returns response with unread body in case of success status code
+func (c *client) do(ctx context.Context, method string, path string, data []byte) (*http.Response, error) {
+	req, err := c.newRequest(ctx, method, path, data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkStatus(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
 }
 
-func (c *client) SendRequest(ctx context.Context, method string, path string, data []byte) ([]byte, error) {
+func (c *client) newRequest(ctx context.Context, method string, path string, data []byte) (*http.Request, error) {
+	if c.transport.err != nil {
+		return nil, fmt.Errorf("invalid http client configuration: %w", c.transport.err)
+	}
+
 	req, err := http.NewRequest(method, c.baseUrl+"/"+path, bytes.NewReader(data))
 	if nil != err {
 		return nil, fmt.Errorf("can't create http request: %w", err)
@@ -98,21 +1299,58 @@ func (c *client) SendRequest(ctx context.Context, method string, path string, da
 	req = req.WithContext(ctx)
 
 	req.Header.Add("Content-Type", "application/json; charset=utf-8")
-	req.Header.Add("Private-Token", c.token)
+	req.Header.Add(c.tokenHeader, c.token)
+
+	for _, opt := range requestOptionsFromContext(ctx) {
+		opt.apply(req)
+	}
+
+	return req, nil
+}
+
+func (c *client) send(req *http.Request) (*http.Response, error) {
+	resp, err := c.roundTrip(req)
+	if ex := exchangeFromContext(req.Context()); ex != nil {
+		ex.finished(resp)
+	}
 
-	var resp *http.Response
-	if resp, err = c.httpClient.Do(req); nil != err {
+	if nil != err {
 		return nil, fmt.Errorf("can't send http request: %w", err)
 	}
 
-	if http.StatusOK != resp.StatusCode {
-		return nil, fmt.Errorf("gitlab respond with %d status code", resp.StatusCode)
+	return resp, nil
+}
+
+// attempt sends http request by http client, it is the innermost round trip wrapped by middlewares
+func (c *client) attempt(req *http.Request) (resp *http.Response, err error) {
+	if ex := exchangeFromContext(req.Context()); ex != nil {
+		ex.started(req)
 	}
 
-	var body []byte
-	if body, err = ioutil.ReadAll(resp.Body); nil != err {
-		return nil, fmt.Errorf("can't read response body: %w", err)
+	req, span := c.startAttemptSpan(req)
+	defer span.end(&err)
+
+	resp, err = c.httpClient.Do(req)
+	span.setStatus(resp)
+
+	return resp, err
+}
+
+// checkStatus closes response body and returns error in case of non success status code
+func checkStatus(resp *http.Response) error {
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		resp.Body.Close()
+		return &statusError{code: resp.StatusCode}
 	}
 
-	return body, nil
+	return nil
+}
+
+// statusError is returned in case of non success status code
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("gitlab respond with %d status code", e.code)
 }
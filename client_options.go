@@ -1,6 +1,13 @@
 // Package gitlab - client options
 package gitlab
 
+import (
+	"crypto/tls"
+	"fmt"
+	"net/url"
+	"time"
+)
+
 type (
 	// ClientOption to use optional parameters in slack client
 	ClientOption interface {
@@ -18,9 +25,85 @@ type (
 	withConcurrency struct {
 		concurrency int
 	}
+
+	withCache struct {
+		cache Cache
+		opts  CacheOptions
+	}
+
+	withMiddleware struct {
+		middlewares []Middleware
+	}
+
+	withLogger struct {
+		logger Logger
+		opts   LoggerOptions
+	}
+
+	withMetrics struct {
+		collector MetricsCollector
+	}
+
+	withTracer struct {
+		tracer Tracer
+	}
+
+	withJobToken struct {
+		token string
+	}
+
+	withCACert struct {
+		pem      []byte
+		filename string
+	}
+
+	withClientCert struct {
+		certPEM, keyPEM   []byte
+		certFile, keyFile string
+	}
+
+	withMinTLSVersion struct {
+		version uint16
+	}
+
+	withInsecureSkipVerify struct{}
+
+	withTimeout struct {
+		timeout time.Duration
+	}
+
+	withAttemptTimeout struct {
+		timeout time.Duration
+	}
+
+	withResponseHeaderTimeout struct {
+		timeout time.Duration
+	}
+
+	withDialTimeout struct {
+		timeout time.Duration
+	}
+
+	withTLSHandshakeTimeout struct {
+		timeout time.Duration
+	}
+
+	withIdleConns struct {
+		max     int
+		timeout time.Duration
+	}
+
+	withHTTP2 struct {
+		enabled bool
+	}
+
+	withProxy struct {
+		proxyUrl string
+	}
 )
 
-// WithHttpClient replaces default http client
+// WithHttpClient replaces default http client, transport options (TLS, proxy, timeouts except WithTimeout etc.)
+// are ignored then
 func WithHttpClient(httpClient HTTPClient) ClientOption {
 	return &withHttpClient{httpClient: httpClient}
 }
@@ -50,3 +133,230 @@ func WithConcurrency(concurrency int) ClientOption {
 func (opt withConcurrency) apply(c *client) {
 	c.concurrency = opt.concurrency
 }
+
+// WithCache enables caching of GET responses with conditional requests by ETags, LRU cache is used if cache is nil,
+// non GET request invalidates responses of its path and parent collections requested with any query (pages, filters)
+func WithCache(cache Cache, opts CacheOptions) ClientOption {
+	if cache == nil {
+		cache = NewLRUCache(defaultCacheSize)
+	}
+
+	return withCache{cache: cache, opts: opts}
+}
+
+func (opt withCache) apply(c *client) {
+	c.cache = newResponseCache(opt.cache, opt.opts)
+}
+
+// WithMiddleware adds round trip interceptors, the first one is the outermost
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return withMiddleware{middlewares: middlewares}
+}
+
+func (opt withMiddleware) apply(c *client) {
+	c.middlewares = append(c.middlewares, opt.middlewares...)
+}
+
+// WithLogger enables logging of every request, credentials are never logged
+func WithLogger(logger Logger, opts LoggerOptions) ClientOption {
+	return withLogger{logger: logger, opts: opts}
+}
+
+func (opt withLogger) apply(c *client) {
+	c.logger = newRequestLogger(opt.logger, opt.opts)
+}
+
+// WithMetrics enables collecting of request metrics
+func WithMetrics(collector MetricsCollector) ClientOption {
+	return withMetrics{collector: collector}
+}
+
+func (opt withMetrics) apply(c *client) {
+	c.metrics = opt.collector
+}
+
+// WithTracer enables tracing of client methods and http attempts
+func WithTracer(tracer Tracer) ClientOption {
+	return withTracer{tracer: tracer}
+}
+
+func (opt withTracer) apply(c *client) {
+	c.tracer = opt.tracer
+}
+
+// WithJobToken authenticates requests by CI job token instead of access token passed to NewClient
+func WithJobToken(token string) ClientOption {
+	return withJobToken{token: token}
+}
+
+func (opt withJobToken) apply(c *client) {
+	c.token, c.tokenHeader = opt.token, jobTokenKey
+}
+
+// WithCACertFile adds certificates of PEM file to trusted ones of default http client
+func WithCACertFile(filename string) ClientOption {
+	return withCACert{filename: filename}
+}
+
+// WithCACertPEM adds PEM encoded certificates to trusted ones of default http client
+func WithCACertPEM(pem []byte) ClientOption {
+	return withCACert{pem: pem}
+}
+
+func (opt withCACert) apply(c *client) {
+	pem := opt.pem
+	if opt.filename != "" {
+		var err error
+		if pem, err = readPEMFile("ca certificate", opt.filename); err != nil {
+			c.transport.fail(err)
+			return
+		}
+	}
+
+	if err := c.transport.addRootCAs(pem); err != nil {
+		c.transport.fail(err)
+	}
+}
+
+// WithClientCertFile sets client certificate of default http client for mutual TLS from PEM files
+func WithClientCertFile(certFile, keyFile string) ClientOption {
+	return withClientCert{certFile: certFile, keyFile: keyFile}
+}
+
+// WithClientCertPEM sets client certificate of default http client for mutual TLS from PEM encoded pair
+func WithClientCertPEM(certPEM, keyPEM []byte) ClientOption {
+	return withClientCert{certPEM: certPEM, keyPEM: keyPEM}
+}
+
+func (opt withClientCert) apply(c *client) {
+	certPEM, keyPEM := opt.certPEM, opt.keyPEM
+	if opt.certFile != "" || opt.keyFile != "" {
+		var err error
+		if certPEM, err = readPEMFile("client certificate", opt.certFile); err != nil {
+			c.transport.fail(err)
+			return
+		}
+
+		if keyPEM, err = readPEMFile("client key", opt.keyFile); err != nil {
+			c.transport.fail(err)
+			return
+		}
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		c.transport.fail(fmt.Errorf("can't load client certificate: %w", err))
+		return
+	}
+
+	cfg := c.transport.tlsConfig()
+	cfg.Certificates = append(cfg.Certificates, cert)
+}
+
+// WithMinTLSVersion sets minimum TLS version (e.g. tls.VersionTLS12) of default http client
+func WithMinTLSVersion(version uint16) ClientOption {
+	return withMinTLSVersion{version: version}
+}
+
+func (opt withMinTLSVersion) apply(c *client) {
+	c.transport.tlsConfig().MinVersion = opt.version
+}
+
+// WithInsecureSkipVerify disables verification of server certificates by default http client,
+// it must be used for development only
+func WithInsecureSkipVerify() ClientOption {
+	return withInsecureSkipVerify{}
+}
+
+func (opt withInsecureSkipVerify) apply(c *client) {
+	c.transport.tlsConfig().InsecureSkipVerify = true
+}
+
+// WithTimeout limits overall duration of request including attempts made by middlewares and reading of response,
+// unlike other timeouts it is applied to http client set by WithHttpClient as well
+func WithTimeout(timeout time.Duration) ClientOption {
+	return withTimeout{timeout: timeout}
+}
+
+func (opt withTimeout) apply(c *client) {
+	c.timeout = opt.timeout
+}
+
+// WithAttemptTimeout limits duration of every http request attempt of default http client including reading of
+// response, it is not limited by default to not cut off downloads of archives
+func WithAttemptTimeout(timeout time.Duration) ClientOption {
+	return withAttemptTimeout{timeout: timeout}
+}
+
+func (opt withAttemptTimeout) apply(c *client) {
+	c.transport.attemptTimeout = opt.timeout
+}
+
+// WithResponseHeaderTimeout limits time default http client waits for response headers after sending request
+// (30 seconds by default), zero timeout disables the limit
+func WithResponseHeaderTimeout(timeout time.Duration) ClientOption {
+	return withResponseHeaderTimeout{timeout: timeout}
+}
+
+func (opt withResponseHeaderTimeout) apply(c *client) {
+	c.transport.responseHeaderTimeout = opt.timeout
+}
+
+// WithDialTimeout limits duration of connection establishing by default http client (30 seconds by default)
+func WithDialTimeout(timeout time.Duration) ClientOption {
+	return withDialTimeout{timeout: timeout}
+}
+
+func (opt withDialTimeout) apply(c *client) {
+	c.transport.dialTimeout = opt.timeout
+}
+
+// WithTLSHandshakeTimeout limits duration of TLS handshake by default http client (10 seconds by default)
+func WithTLSHandshakeTimeout(timeout time.Duration) ClientOption {
+	return withTLSHandshakeTimeout{timeout: timeout}
+}
+
+func (opt withTLSHandshakeTimeout) apply(c *client) {
+	c.transport.tlsHandshakeTimeout = opt.timeout
+}
+
+// WithIdleConns sets the maximum number of idle connections to gitlab kept by default http client
+// (concurrency by default) and the time they are kept for, zero values leave defaults
+func WithIdleConns(max int, timeout time.Duration) ClientOption {
+	return withIdleConns{max: max, timeout: timeout}
+}
+
+func (opt withIdleConns) apply(c *client) {
+	c.transport.maxIdleConns = opt.max
+	c.transport.idleConnTimeout = opt.timeout
+}
+
+// WithHTTP2 enables or disables HTTP/2 of default http client, it is enabled by default
+func WithHTTP2(enabled bool) ClientOption {
+	return withHTTP2{enabled: enabled}
+}
+
+func (opt withHTTP2) apply(c *client) {
+	c.transport.disableHTTP2 = !opt.enabled
+}
+
+// WithProxy sets proxy url (e.g. "http://proxy.local:3128") of default http client instead of proxy of environment,
+// empty url disables proxy
+func WithProxy(proxyUrl string) ClientOption {
+	return withProxy{proxyUrl: proxyUrl}
+}
+
+func (opt withProxy) apply(c *client) {
+	c.transport.setProxy, c.transport.proxy = true, nil
+	if opt.proxyUrl == "" {
+		return
+	}
+
+	proxy, err := url.Parse(opt.proxyUrl)
+	if err != nil {
+		c.transport.fail(fmt.Errorf("can't parse proxy url: %w", err))
+		return
+	}
+
+	c.transport.proxy = proxy
+}
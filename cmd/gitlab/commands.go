@@ -0,0 +1,305 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+var commands = map[string]command{
+	"user get": {
+		usage: "user get <user-id>",
+		run:   userGet,
+	},
+	"user batch": {
+		usage: "user batch <user-id>...",
+		run:   userBatch,
+	},
+	"discussion get": {
+		usage: "discussion get <project-id> <mr-iid> <discussion-id>",
+		run:   discussionGet,
+	},
+	"discussion list": {
+		usage: "discussion list [-page n] [-per-page n] <project-id> <mr-iid>",
+		run:   discussionList,
+	},
+	"discussion participants": {
+		usage: "discussion participants <project-id> <mr-iid> <discussion-id>",
+		run:   discussionParticipants,
+	},
+	"mr list": {
+		usage: "mr list [-state s] [-source-branch b] [-target-branch b] [-search s] [-page n] [-per-page n] <project-id>",
+		run:   mrList,
+	},
+	"mr get": {
+		usage: "mr get <project-id> <mr-iid>",
+		run:   mrGet,
+	},
+	"mr participants": {
+		usage: "mr participants [-exclude-system-notes] [-exclude-bots] <project-id> <mr-iid>",
+		run:   mrParticipants,
+	},
+	"api": {
+		usage: "api [-X method] [-d data|@file|@-] <path>",
+		run:   api,
+	},
+}
+
+func userGet(ctx context.Context, e *env, args []string) error {
+	ids, err := parseIDs(args, 1)
+	if err != nil {
+		return err
+	}
+
+	user, err := e.client.Users().GetByID(ctx, ids[0])
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(user)
+}
+
+func userBatch(ctx context.Context, e *env, args []string) error {
+	if len(args) == 0 {
+		return usageError{msg: "at least one user id is required"}
+	}
+
+	var ids []int
+	for _, arg := range args {
+		for _, value := range strings.Split(arg, ",") {
+			id, err := strconv.Atoi(value)
+			if err != nil {
+				return usageError{msg: fmt.Sprintf("invalid user id %q", value)}
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	users, err := e.client.Users().GetByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(users)
+}
+
+func discussionGet(ctx context.Context, e *env, args []string) error {
+	if len(args) != 3 {
+		return usageError{msg: "project id, merge request iid and discussion id are required"}
+	}
+
+	ids, err := parseIDs(args[:2], 2)
+	if err != nil {
+		return err
+	}
+
+	discussion, err := e.client.Discussions().Get(ctx, ids[0], ids[1], args[2])
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(discussion)
+}
+
+func discussionList(ctx context.Context, e *env, args []string) error {
+	flags := newFlagSet("discussion list")
+	opts := listOptionsFlags(flags)
+	if err := e.parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	ids, err := parseIDs(flags.Args(), 2)
+	if err != nil {
+		return err
+	}
+
+	discussions, err := e.client.Discussions().List(ctx, ids[0], ids[1], *opts)
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(discussions)
+}
+
+func discussionParticipants(ctx context.Context, e *env, args []string) error {
+	if len(args) != 3 {
+		return usageError{msg: "project id, merge request iid and discussion id are required"}
+	}
+
+	ids, err := parseIDs(args[:2], 2)
+	if err != nil {
+		return err
+	}
+
+	participants, err := e.client.Discussions().GetParticipants(ctx, ids[0], ids[1], args[2])
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(participants)
+}
+
+func mrList(ctx context.Context, e *env, args []string) error {
+	flags := newFlagSet("mr list")
+
+	var opts gitlab.ListMergeRequestsOptions
+	flags.StringVar(&opts.State, "state", "", "opened, closed, locked, merged or all")
+	flags.StringVar(&opts.SourceBranch, "source-branch", "", "source branch")
+	flags.StringVar(&opts.TargetBranch, "target-branch", "", "target branch")
+	flags.StringVar(&opts.Search, "search", "", "search in title and description")
+	listOpts := listOptionsFlags(flags)
+
+	if err := e.parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	ids, err := parseIDs(flags.Args(), 1)
+	if err != nil {
+		return err
+	}
+
+	opts.ListOptions = *listOpts
+
+	mrs, err := e.client.MergeRequests().List(ctx, ids[0], opts)
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(mrs)
+}
+
+func mrGet(ctx context.Context, e *env, args []string) error {
+	ids, err := parseIDs(args, 2)
+	if err != nil {
+		return err
+	}
+
+	mr, err := e.client.MergeRequests().Get(ctx, ids[0], ids[1])
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(mr)
+}
+
+func mrParticipants(ctx context.Context, e *env, args []string) error {
+	flags := newFlagSet("mr participants")
+
+	var opts gitlab.ParticipantsReportOptions
+	flags.BoolVar(&opts.ExcludeSystemNotes, "exclude-system-notes", false, "skip system notes")
+	flags.BoolVar(&opts.ExcludeBots, "exclude-bots", false, "skip bot users")
+
+	if err := e.parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	ids, err := parseIDs(flags.Args(), 2)
+	if err != nil {
+		return err
+	}
+
+	participants, err := e.client.MergeRequests().GetParticipants(ctx, ids[0], ids[1], opts)
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(participants)
+}
+
+func api(ctx context.Context, e *env, args []string) error {
+	flags := newFlagSet("api")
+	method := flags.String("X", http.MethodGet, "http method")
+	data := flags.String("d", "", "request body, @file reads it from file and @- from stdin")
+
+	if err := e.parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return usageError{msg: "path is required"}
+	}
+
+	var body []byte
+	switch {
+	case *data == "@-":
+		var err error
+		if body, err = ioutil.ReadAll(e.stdin); err != nil {
+			return fmt.Errorf("can't read request body: %w", err)
+		}
+	case strings.HasPrefix(*data, "@"):
+		var err error
+		if body, err = ioutil.ReadFile(strings.TrimPrefix(*data, "@")); err != nil {
+			return fmt.Errorf("can't read request body: %w", err)
+		}
+	case *data != "":
+		body = []byte(*data)
+	}
+
+	path := strings.TrimPrefix(flags.Arg(0), "/")
+
+	resp, err := e.client.SendRequest(ctx, strings.ToUpper(*method), path, body)
+	if err != nil {
+		if failed := bytes.TrimSpace(e.failed.body()); len(failed) > 0 {
+			return fmt.Errorf("%w: %s", err, failed)
+		}
+
+		return err
+	}
+
+	return e.out.printRaw(resp)
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+
+	return flags
+}
+
+// parseFlags parses flags of subcommand printing their defaults to stderr of env on -h
+func (e *env) parseFlags(flags *flag.FlagSet, args []string) error {
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			flags.SetOutput(e.stderr)
+			flags.PrintDefaults()
+		}
+
+		return usageError{msg: err.Error()}
+	}
+
+	return nil
+}
+
+func listOptionsFlags(flags *flag.FlagSet) *gitlab.ListOptions {
+	var opts gitlab.ListOptions
+	flags.IntVar(&opts.Page, "page", 0, "page number")
+	flags.IntVar(&opts.PerPage, "per-page", 0, "page size")
+
+	return &opts
+}
+
+// parseIDs parses exactly n integer ids
+func parseIDs(args []string, n int) ([]int, error) {
+	if len(args) != n {
+		return nil, usageError{msg: fmt.Sprintf("%d id(s) expected, got %d", n, len(args))}
+	}
+
+	ids := make([]int, 0, n)
+	for _, arg := range args {
+		id, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, usageError{msg: fmt.Sprintf("invalid id %q", arg)}
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,237 @@
+// Command gitlab is a command-line interface to gitlab api built on the gitlab client.
+//
+// Usage:
+//
+//	gitlab [-config file] [-profile name] [-token token] [-url url] [-sudo user] [-o json|table|template]
+//	       [-template text] <command> <subcommand> [args]
+//
+// Token and url are taken from flags, environment (GITLAB_TOKEN, GITLAB_URL, CI_JOB_TOKEN, CI_SERVER_URL)
+// or profile of config file (see gitlab.Config).
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+type (
+	// env contains dependencies of command execution
+	env struct {
+		client gitlab.Client
+		out    *printer
+		stdin  io.Reader
+		stderr io.Writer
+		failed *failedResponse
+	}
+
+	// command runs subcommand with its arguments
+	command struct {
+		usage string
+		run   func(ctx context.Context, e *env, args []string) error
+	}
+
+	// usageError is returned on invalid arguments
+	usageError struct {
+		msg string
+	}
+
+	// failedResponse keeps body of the last non success response, gitlab explains errors in it
+	failedResponse struct {
+		mu   sync.Mutex
+		data []byte
+	}
+)
+
+func (e usageError) Error() string {
+	return e.msg
+}
+
+// middleware keeps body of the last non success response for api command to print it with the error
+func (r *failedResponse) middleware(next gitlab.RoundTripFunc) gitlab.RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		resp, err := next(req)
+		if err != nil || resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+			return resp, err
+		}
+
+		data, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+		if err != nil {
+			return resp, nil
+		}
+
+		r.mu.Lock()
+		r.data = data
+		r.mu.Unlock()
+
+		return resp, nil
+	}
+}
+
+func (r *failedResponse) body() []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.data
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	go func() {
+		<-signals
+		cancel()
+	}()
+
+	code := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	cancel()
+
+	os.Exit(code)
+}
+
+// run executes command line and returns exit code
+func run(
+	ctx context.Context,
+	args []string,
+	stdin io.Reader,
+	stdout, stderr io.Writer,
+) int {
+	flags := flag.NewFlagSet("gitlab", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	configPath := flags.String("config", gitlab.DefaultConfigPath(), "config file")
+	profileName := flags.String("profile", "", "config profile, GITLAB_PROFILE or default profile if empty")
+	token := flags.String("token", "", "access token, overrides profile one")
+	instance := flags.String("url", "", "instance url, overrides profile one")
+	sudo := flags.String("sudo", "", "user name or id to perform requests as, requires admin token")
+	format := flags.String("o", formatJSON, "output format: json, table or template")
+	tmpl := flags.String("template", "", "go template of output if -o template is set")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: gitlab [flags] <command> <subcommand> [args]\n\nFlags:\n")
+		flags.PrintDefaults()
+		fmt.Fprintf(stderr, "\nCommands:\n%s", commandsUsage())
+	}
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	cmd, cmdArgs, err := findCommand(flags.Args())
+	if err != nil {
+		fmt.Fprintf(stderr, "gitlab: %s\n\n", err)
+		flags.Usage()
+		return exitUsage
+	}
+
+	out, err := newPrinter(stdout, *format, *tmpl)
+	if err != nil {
+		fmt.Fprintf(stderr, "gitlab: %s\n", err)
+		return exitUsage
+	}
+
+	profile, err := loadProfile(*configPath, *profileName)
+	if err != nil {
+		fmt.Fprintf(stderr, "gitlab: %s\n", err)
+		return exitError
+	}
+
+	if *token != "" {
+		profile.Token = *token
+	}
+
+	if *instance != "" {
+		profile.URL = *instance
+	}
+
+	if *sudo != "" {
+		ctx = gitlab.ContextWithRequestOptions(ctx, gitlab.WithSudo(*sudo))
+	}
+
+	failed := new(failedResponse)
+	e := &env{
+		client: profile.NewClient(gitlab.WithMiddleware(failed.middleware)),
+		out:    out,
+		stdin:  stdin,
+		stderr: stderr,
+		failed: failed,
+	}
+	if err = cmd.run(ctx, e, cmdArgs); err != nil {
+		var uerr usageError
+		if errors.As(err, &uerr) {
+			fmt.Fprintf(stderr, "gitlab: %s\nUsage: gitlab %s\n", err, cmd.usage)
+			return exitUsage
+		}
+
+		fmt.Fprintf(stderr, "gitlab: %s\n", err)
+		return exitError
+	}
+
+	return exitOK
+}
+
+func loadProfile(configPath string, name string) (gitlab.Profile, error) {
+	cfg, err := gitlab.LoadConfig(configPath)
+	if err != nil {
+		return gitlab.Profile{}, err
+	}
+
+	return cfg.Profile(name)
+}
+
+func findCommand(args []string) (command, []string, error) {
+	if len(args) == 0 {
+		return command{}, nil, errors.New("command is required")
+	}
+
+	if cmd, has := commands[args[0]]; has {
+		return cmd, args[1:], nil
+	}
+
+	if len(args) > 1 {
+		if cmd, has := commands[args[0]+" "+args[1]]; has {
+			return cmd, args[2:], nil
+		}
+	}
+
+	return command{}, nil, fmt.Errorf("unknown command %q", strings.Join(args, " "))
+}
+
+func commandsUsage() string {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, "  %s\n", commands[name].usage)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-gitlab"
+	"github.com/kryabinin/go-gitlab/gitlabtest"
+)
+
+func TestRun(t *testing.T) {
+	server := gitlabtest.NewServer()
+	defer server.Close()
+
+	server.AddUser(gitlab.User{ID: 1, Name: "John", UserName: "john"})
+	server.AddUser(gitlab.User{ID: 2, Name: "Jane", UserName: "jane"})
+	server.AddProject(gitlabtest.Project{ID: 10, Name: "project"})
+
+	_, err := server.AddMergeRequest(10, gitlab.MergeRequest{Title: "Add feature", Author: gitlab.NoteAuthor{ID: 1}})
+	assert.NoError(t, err)
+
+	discussion, err := server.AddDiscussion(10, 1, gitlab.Discussion{Notes: []gitlab.Note{
+		{Body: "comment", Author: gitlab.NoteAuthor{ID: 2, UserName: "jane"}},
+	}})
+	assert.NoError(t, err)
+
+	exec := func(stdin string, args ...string) (int, string, string) {
+		var stdout, stderr bytes.Buffer
+		args = append([]string{"-config", "", "-url", server.URL(), "-token", gitlabtest.DefaultToken}, args...)
+		code := run(context.Background(), args, strings.NewReader(stdin), &stdout, &stderr)
+
+		return code, stdout.String(), stderr.String()
+	}
+
+	t.Run("user get", func(t *testing.T) {
+		code, stdout, _ := exec("", "user", "get", "1")
+		assert.Equal(t, exitOK, code)
+		assert.JSONEq(t, `{"id": 1, "name": "John", "username": "john", "public_email": ""}`, stdout)
+	})
+
+	t.Run("user batch as table", func(t *testing.T) {
+		code, stdout, _ := exec("", "-o", "table", "user", "batch", "1,2")
+		assert.Equal(t, exitOK, code)
+
+		lines := strings.Split(strings.TrimSpace(stdout), "\n")
+		if assert.Len(t, lines, 3) {
+			assert.Equal(t, []string{"ID", "NAME", "USERNAME", "PUBLIC_EMAIL"}, strings.Fields(lines[0]))
+		}
+	})
+
+	t.Run("discussion participants as template", func(t *testing.T) {
+		code, stdout, _ := exec("", "-o", "template", "-template", "{{range .}}{{.UserName}}{{end}}",
+			"discussion", "participants", "10", "1", discussion.ID)
+		assert.Equal(t, exitOK, code)
+		assert.Equal(t, "jane\n", stdout)
+	})
+
+	t.Run("mr list", func(t *testing.T) {
+		code, stdout, _ := exec("", "-o", "template", "-template", "{{range .}}!{{.IID}} {{.Title}}{{end}}",
+			"mr", "list", "-state", "opened", "10")
+		assert.Equal(t, exitOK, code)
+		assert.Equal(t, "!1 Add feature\n", stdout)
+	})
+
+	t.Run("api", func(t *testing.T) {
+		code, _, _ := exec(`{"body": "LGTM"}`, "api", "-X", "post", "-d", "@-", "/projects/10/merge_requests/1/discussions")
+		assert.Equal(t, exitOK, code)
+
+		code, stdout, _ := exec("", "-o", "table", "api", "projects/10")
+		assert.Equal(t, exitOK, code)
+		assert.True(t, strings.HasPrefix(stdout, "DEFAULT_BRANCH"))
+
+		code, stdout, stderr := exec("", "api", "-X", "post", "projects/10/merge_requests/1/discussions")
+		assert.Equal(t, exitError, code)
+		assert.Empty(t, stdout)
+		assert.Equal(t, "gitlab: gitlab respond with 400 status code: {\"message\":\"400 Bad request - body is missing\"}\n",
+			stderr)
+	})
+
+	t.Run("sudo", func(t *testing.T) {
+		code, _, _ := exec("", "-sudo", "jane", "user", "get", "1")
+		assert.Equal(t, exitOK, code)
+
+		requests := server.Requests()
+		assert.Equal(t, "jane", requests[len(requests)-1].Sudo)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		code, _, stderr := exec("", "user", "get", "3")
+		assert.Equal(t, exitError, code)
+		assert.Equal(t, "gitlab: gitlab respond with 404 status code\n", stderr)
+
+		code, _, stderr = exec("", "user", "get", "john")
+		assert.Equal(t, exitUsage, code)
+		assert.Contains(t, stderr, `invalid id "john"`)
+
+		code, _, stderr = exec("", "user", "remove")
+		assert.Equal(t, exitUsage, code)
+		assert.Contains(t, stderr, `unknown command "user remove"`)
+
+		code, _, _ = exec("", "-o", "xml", "user", "get", "1")
+		assert.Equal(t, exitUsage, code)
+
+		code, _, stderr = exec("", "discussion", "list", "-h")
+		assert.Equal(t, exitUsage, code)
+		assert.Contains(t, stderr, "-per-page")
+	})
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+const (
+	formatJSON     = "json"
+	formatTable    = "table"
+	formatTemplate = "template"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	gitlabTimeType = reflect.TypeOf(gitlab.Time{})
+)
+
+// printer writes command results in selected format
+type printer struct {
+	w      io.Writer
+	format string
+	tmpl   *template.Template
+}
+
+func newPrinter(w io.Writer, format string, text string) (*printer, error) {
+	p := &printer{w: w, format: format}
+
+	switch format {
+	case formatJSON, formatTable:
+	case formatTemplate:
+		if text == "" {
+			return nil, fmt.Errorf("template is required for %s output", formatTemplate)
+		}
+
+		var err error
+		if p.tmpl, err = template.New("output").Parse(text); err != nil {
+			return nil, fmt.Errorf("can't parse template: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown output format %q", format)
+	}
+
+	return p, nil
+}
+
+func (p *printer) print(v interface{}) error {
+	switch p.format {
+	case formatTable:
+		return p.table(v)
+	case formatTemplate:
+		var b bytes.Buffer
+		if err := p.tmpl.Execute(&b, v); err != nil {
+			return fmt.Errorf("can't execute template: %w", err)
+		}
+
+		if b.Len() > 0 && !bytes.HasSuffix(b.Bytes(), []byte("\n")) {
+			b.WriteByte('\n')
+		}
+
+		_, err := p.w.Write(b.Bytes())
+		return err
+	}
+
+	enc := json.NewEncoder(p.w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+// printRaw prints raw response, non JSON responses are written as is
+func (p *printer) printRaw(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		if len(data) == 0 {
+			return nil
+		}
+
+		_, err = p.w.Write(data)
+		return err
+	}
+
+	return p.print(v)
+}
+
+// table prints struct, map or slice of them as a table of scalar fields
+func (p *printer) table(v interface{}) error {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+
+	var rows []reflect.Value
+	if rv.Kind() == reflect.Slice {
+		for i := 0; i < rv.Len(); i++ {
+			rows = append(rows, indirect(rv.Index(i)))
+		}
+	} else {
+		rows = append(rows, rv)
+	}
+
+	columns, values := tableColumns(rows)
+
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(columns, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(values(row), "\t"))
+	}
+
+	return tw.Flush()
+}
+
+// tableColumns returns column names and function returning cells of a row
+func tableColumns(rows []reflect.Value) ([]string, func(reflect.Value) []string) {
+	if len(rows) == 0 {
+		return nil, func(reflect.Value) []string { return nil }
+	}
+
+	switch rows[0].Kind() {
+	case reflect.Struct:
+		var columns []string
+		var fields [][]int
+
+		var collect func(t reflect.Type, index []int)
+		collect = func(t reflect.Type, index []int) {
+			for i := 0; i < t.NumField(); i++ {
+				field := t.Field(i)
+				if field.PkgPath != "" {
+					continue
+				}
+
+				fieldIndex := append(append([]int(nil), index...), i)
+				if field.Anonymous && field.Type.Kind() == reflect.Struct {
+					collect(field.Type, fieldIndex)
+					continue
+				}
+
+				if isScalar(field.Type) {
+					columns = append(columns, columnName(field))
+					fields = append(fields, fieldIndex)
+				}
+			}
+		}
+		collect(rows[0].Type(), nil)
+
+		return columns, func(row reflect.Value) []string {
+			cells := make([]string, 0, len(fields))
+			if row.Kind() != reflect.Struct {
+				return cells
+			}
+
+			for _, index := range fields {
+				cells = append(cells, cell(row.FieldByIndex(index)))
+			}
+			return cells
+		}
+	case reflect.Map:
+		keys := map[string]bool{}
+		for _, row := range rows {
+			if row.Kind() != reflect.Map {
+				continue
+			}
+
+			for _, key := range row.MapKeys() {
+				if value := indirect(row.MapIndex(key)); value.IsValid() && isScalar(value.Type()) {
+					keys[fmt.Sprint(key.Interface())] = true
+				}
+			}
+		}
+
+		columns := make([]string, 0, len(keys))
+		for key := range keys {
+			columns = append(columns, key)
+		}
+		sort.Strings(columns)
+
+		headers := make([]string, 0, len(columns))
+		for _, column := range columns {
+			headers = append(headers, strings.ToUpper(column))
+		}
+
+		return headers, func(row reflect.Value) []string {
+			cells := make([]string, 0, len(columns))
+			if row.Kind() != reflect.Map {
+				return cells
+			}
+
+			for _, column := range columns {
+				cells = append(cells, cell(row.MapIndex(reflect.ValueOf(column))))
+			}
+			return cells
+		}
+	}
+
+	return []string{"VALUE"}, func(row reflect.Value) []string { return []string{cell(row)} }
+}
+
+func columnName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		name = field.Name
+	}
+
+	return strings.ToUpper(name)
+}
+
+func isScalar(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return t == timeType || t == gitlabTimeType
+}
+
+func cell(v reflect.Value) string {
+	v = indirect(v)
+	if !v.IsValid() {
+		return ""
+	}
+
+	switch t := v.Interface().(type) {
+	case time.Time:
+		return t.Format(time.RFC3339)
+	case gitlab.Time:
+		return t.Format(time.RFC3339)
+	}
+
+	return fmt.Sprint(v.Interface())
+}
+
+// indirect dereferences pointers and interfaces
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+
+	return v
+}
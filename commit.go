@@ -0,0 +1,19 @@
+// Package gitlab - commit
+package gitlab
+
+// Commit entity
+type Commit struct {
+	ID             string   `json:"id"`
+	ShortID        string   `json:"short_id"`
+	Title          string   `json:"title"`
+	Message        string   `json:"message"`
+	AuthorName     string   `json:"author_name"`
+	AuthorEmail    string   `json:"author_email"`
+	AuthoredDate   *Time    `json:"authored_date"`
+	CommitterName  string   `json:"committer_name"`
+	CommitterEmail string   `json:"committer_email"`
+	CommittedDate  *Time    `json:"committed_date"`
+	CreatedAt      *Time    `json:"created_at"`
+	ParentIDs      []string `json:"parent_ids"`
+	WebUrl         string   `json:"web_url"`
+}
@@ -0,0 +1,195 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+// setEnv sets environment variables (unsets empty ones) and returns function restoring previous values
+func setEnv(values map[string]string) func() {
+	previous := map[string]*string{}
+	for name, value := range values {
+		if old, has := os.LookupEnv(name); has {
+			previous[name] = &old
+		} else {
+			previous[name] = nil
+		}
+
+		if value == "" {
+			os.Unsetenv(name)
+		} else {
+			os.Setenv(name, value)
+		}
+	}
+
+	return func() {
+		for name, value := range previous {
+			if value == nil {
+				os.Unsetenv(name)
+			} else {
+				os.Setenv(name, *value)
+			}
+		}
+	}
+}
+
+func cleanEnv(values map[string]string) map[string]string {
+	res := map[string]string{
+		gitlab.EnvToken:       "",
+		gitlab.EnvURL:         "",
+		gitlab.EnvProfile:     "",
+		gitlab.EnvCIServerURL: "",
+		gitlab.EnvCIJobToken:  "",
+	}
+
+	for name, value := range values {
+		res[name] = value
+	}
+
+	return res
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gitlab")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	t.Run("positive case", func(t *testing.T) {
+		filename := filepath.Join(dir, "config.yml")
+		assert.NoError(t, ioutil.WriteFile(filename, []byte(`
+default_profile: work
+profiles:
+  work:
+    url: gitlab.example.com
+    token: work_token
+    proxy: http://proxy.example.com:3128
+    timeout: 30s
+  public:
+    token_env: PUBLIC_TOKEN
+`), 0600))
+
+		cfg, err := gitlab.LoadConfig(filename)
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.Config{
+			DefaultProfile: "work",
+			Profiles: map[string]gitlab.Profile{
+				"work": {
+					URL:     "gitlab.example.com",
+					Token:   "work_token",
+					Proxy:   "http://proxy.example.com:3128",
+					Timeout: 30 * time.Second,
+				},
+				"public": {TokenEnv: "PUBLIC_TOKEN"},
+			},
+		}, cfg)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		cfg, err := gitlab.LoadConfig(filepath.Join(dir, "missing.yml"))
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.Config{}, cfg)
+	})
+
+	t.Run("error on unmarshal", func(t *testing.T) {
+		filename := filepath.Join(dir, "invalid.yml")
+		assert.NoError(t, ioutil.WriteFile(filename, []byte("profiles: ["), 0600))
+
+		_, err := gitlab.LoadConfig(filename)
+		assert.Error(t, err)
+	})
+}
+
+func TestConfig_Profile(t *testing.T) {
+	cfg := gitlab.Config{
+		DefaultProfile: "work",
+		Profiles: map[string]gitlab.Profile{
+			"work":   {URL: "gitlab.example.com", Token: "work_token"},
+			"public": {TokenEnv: "PUBLIC_TOKEN"},
+		},
+	}
+
+	t.Run("default profile with environment", func(t *testing.T) {
+		defer setEnv(cleanEnv(map[string]string{gitlab.EnvToken: "env_token"}))()
+
+		profile, err := cfg.Profile("")
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.Profile{URL: "gitlab.example.com", Token: "env_token"}, profile)
+		assert.Equal(t, "https://gitlab.example.com/api/v4", profile.BaseUrl())
+	})
+
+	t.Run("explicit profile", func(t *testing.T) {
+		defer setEnv(cleanEnv(map[string]string{
+			gitlab.EnvToken: "env_token",
+			gitlab.EnvURL:   "http://localhost:8080/",
+			"PUBLIC_TOKEN":  "public_token",
+		}))()
+
+		profile, err := cfg.Profile("work")
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.Profile{URL: "gitlab.example.com", Token: "work_token"}, profile)
+
+		profile, err = cfg.Profile("public")
+		assert.NoError(t, err)
+		assert.Equal(t, "public_token", profile.Token)
+		assert.Equal(t, "http://localhost:8080/api/v4", profile.BaseUrl())
+	})
+
+	t.Run("profile from environment", func(t *testing.T) {
+		defer setEnv(cleanEnv(map[string]string{gitlab.EnvProfile: "unknown"}))()
+
+		_, err := cfg.Profile("")
+		assert.True(t, errors.Is(err, gitlab.ErrProfileNotFound))
+	})
+
+	t.Run("ci job", func(t *testing.T) {
+		defer setEnv(cleanEnv(map[string]string{
+			gitlab.EnvCIServerURL: "https://gitlab.ci.com",
+			gitlab.EnvCIJobToken:  "job_token",
+		}))()
+
+		profile, err := gitlab.Config{}.Profile("")
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.Profile{URL: "https://gitlab.ci.com", JobToken: "job_token"}, profile)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		defer setEnv(cleanEnv(nil))()
+
+		profile, err := gitlab.Config{}.Profile("")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://gitlab.com/api/v4", profile.BaseUrl())
+	})
+}
+
+func TestProfile_NewClient(t *testing.T) {
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, "https://gitlab.ci.com/api/v4//users/5", req.URL.String())
+		assert.Equal(t, "job_token", req.Header.Get("Job-Token"))
+		assert.Empty(t, req.Header.Get("Private-Token"))
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 5}`))),
+		StatusCode: http.StatusOK,
+	}, nil).Once()
+
+	profile := gitlab.Profile{URL: "gitlab.ci.com/api/v4/", JobToken: "job_token"}
+	client := profile.NewClient(gitlab.WithHttpClient(httpClient))
+
+	_, err := client.GetUserByID(context.Background(), 5)
+	assert.NoError(t, err)
+}
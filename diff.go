@@ -0,0 +1,147 @@
+// Package gitlab - diff
+package gitlab
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DiffLineType is a type of diff line
+type DiffLineType string
+
+// Diff line types
+const (
+	DiffLineContext DiffLineType = "context"
+	DiffLineAdded   DiffLineType = "added"
+	DiffLineRemoved DiffLineType = "removed"
+)
+
+var hunkHeaderRegexp = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@ ?(.*)$`)
+
+type (
+	// Diff (changes of single file) entity
+	Diff struct {
+		OldPath     string `json:"old_path"`
+		NewPath     string `json:"new_path"`
+		AMode       string `json:"a_mode"`
+		BMode       string `json:"b_mode"`
+		Diff        string `json:"diff"`
+		NewFile     bool   `json:"new_file"`
+		RenamedFile bool   `json:"renamed_file"`
+		DeletedFile bool   `json:"deleted_file"`
+	}
+
+	// DiffHunk is a single hunk of unified diff
+	DiffHunk struct {
+		OldStart int
+		OldLines int
+		NewStart int
+		NewLines int
+		// Section is a text after hunk range (usually the enclosing function)
+		Section string
+		Lines   []DiffLine
+	}
+
+	// DiffLine is a single line of diff hunk, OldLine is zero for added lines and NewLine is zero for removed ones
+	DiffLine struct {
+		Type    DiffLineType
+		OldLine int
+		NewLine int
+		Content string
+	}
+)
+
+// Hunks parses diff text of the file
+func (d Diff) Hunks() ([]DiffHunk, error) {
+	return ParseDiff(d.Diff)
+}
+
+// ParseDiff parses unified diff text into hunks with old and new line numbers
+func ParseDiff(diff string) ([]DiffHunk, error) {
+	hunks := make([]DiffHunk, 0)
+	if diff == "" {
+		return hunks, nil
+	}
+
+	var (
+		hunk             *DiffHunk
+		oldLine, newLine int
+	)
+
+	for i, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		if strings.HasPrefix(line, "@@") {
+			parsed, err := parseHunkHeader(line)
+			if err != nil {
+				return nil, fmt.Errorf("can't parse line %d of diff: %w", i+1, err)
+			}
+
+			hunks = append(hunks, parsed)
+			hunk = &hunks[len(hunks)-1]
+			oldLine, newLine = hunk.OldStart, hunk.NewStart
+
+			continue
+		}
+
+		// file headers ("diff --git", "index", "---", "+++") precede the first hunk
+		if hunk == nil {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "+"):
+			hunk.Lines = append(hunk.Lines, DiffLine{Type: DiffLineAdded, NewLine: newLine, Content: line[1:]})
+			newLine++
+		case strings.HasPrefix(line, "-"):
+			hunk.Lines = append(hunk.Lines, DiffLine{Type: DiffLineRemoved, OldLine: oldLine, Content: line[1:]})
+			oldLine++
+		case strings.HasPrefix(line, " "), line == "":
+			content := line
+			if content != "" {
+				content = content[1:]
+			}
+
+			hunk.Lines = append(hunk.Lines, DiffLine{
+				Type:    DiffLineContext,
+				OldLine: oldLine,
+				NewLine: newLine,
+				Content: content,
+			})
+			oldLine++
+			newLine++
+		case strings.HasPrefix(line, `\`):
+			// "\ No newline at end of file"
+			continue
+		default:
+			return nil, fmt.Errorf("unexpected line %d of diff: %q", i+1, line)
+		}
+	}
+
+	return hunks, nil
+}
+
+func parseHunkHeader(line string) (DiffHunk, error) {
+	matches := hunkHeaderRegexp.FindStringSubmatch(line)
+	if matches == nil {
+		return DiffHunk{}, fmt.Errorf("invalid hunk header %q", line)
+	}
+
+	hunk := DiffHunk{
+		OldStart: atoiOrDefault(matches[1], 0),
+		OldLines: atoiOrDefault(matches[2], 1),
+		NewStart: atoiOrDefault(matches[3], 0),
+		NewLines: atoiOrDefault(matches[4], 1),
+		Section:  matches[5],
+	}
+
+	return hunk, nil
+}
+
+func atoiOrDefault(s string, def int) int {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n
+	}
+
+	return def
+}
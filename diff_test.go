@@ -0,0 +1,71 @@
+package gitlab_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestParseDiff(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		diff := "--- a/main.go\n" +
+			"+++ b/main.go\n" +
+			"@@ -1,3 +1,4 @@ package main\n" +
+			" first\n" +
+			"-second\n" +
+			"+second changed\n" +
+			"+inserted\n" +
+			" third\n" +
+			"@@ -10 +11,0 @@\n" +
+			"-removed\n" +
+			"\\ No newline at end of file\n"
+
+		hunks, err := gitlab.ParseDiff(diff)
+		assert.NoError(t, err)
+		assert.Equal(t, []gitlab.DiffHunk{
+			{
+				OldStart: 1,
+				OldLines: 3,
+				NewStart: 1,
+				NewLines: 4,
+				Section:  "package main",
+				Lines: []gitlab.DiffLine{
+					{Type: gitlab.DiffLineContext, OldLine: 1, NewLine: 1, Content: "first"},
+					{Type: gitlab.DiffLineRemoved, OldLine: 2, Content: "second"},
+					{Type: gitlab.DiffLineAdded, NewLine: 2, Content: "second changed"},
+					{Type: gitlab.DiffLineAdded, NewLine: 3, Content: "inserted"},
+					{Type: gitlab.DiffLineContext, OldLine: 3, NewLine: 4, Content: "third"},
+				},
+			},
+			{
+				OldStart: 10,
+				OldLines: 1,
+				NewStart: 11,
+				NewLines: 0,
+				Lines: []gitlab.DiffLine{
+					{Type: gitlab.DiffLineRemoved, OldLine: 10, Content: "removed"},
+				},
+			},
+		}, hunks)
+	})
+
+	t.Run("empty diff", func(t *testing.T) {
+		hunks, err := gitlab.Diff{}.Hunks()
+		assert.NoError(t, err)
+		assert.Empty(t, hunks)
+	})
+
+	t.Run("invalid hunk header", func(t *testing.T) {
+		hunks, err := gitlab.ParseDiff("@@ -a +b @@\n context\n")
+		assert.Error(t, err)
+		assert.Nil(t, hunks)
+	})
+
+	t.Run("unexpected line", func(t *testing.T) {
+		hunks, err := gitlab.ParseDiff("@@ -1 +1 @@\n context\n?unknown\n")
+		assert.Error(t, err)
+		assert.Nil(t, hunks)
+	})
+}
@@ -21,8 +21,8 @@ type (
 		Type         string     `json:"type"`
 		Body         string     `json:"body"`
 		Author       NoteAuthor `json:"author"`
-		CreatedAt    string     `json:"created_at"`
-		UpdatedAt    string     `json:"updated_at"`
+		CreatedAt    *Time      `json:"created_at"`
+		UpdatedAt    *Time      `json:"updated_at"`
 		System       bool       `json:"system"`
 		NoteableID   int        `json:"noteable_id"`
 		NoteableType string     `json:"noteable_type"`
@@ -44,14 +44,33 @@ type (
 
 	// Position entity
 	Position struct {
-		BaseSha      string `json:"base_sha"`
-		StartSha     string `json:"start_sha"`
-		HeadSha      string `json:"head_sha"`
-		OldPath      string `json:"old_path"`
-		NewPath      string `json:"new_path"`
-		PositionType string `json:"position_type"`
-		OldLine      int    `json:"old_line"`
-		NewLine      int    `json:"new_line"`
+		BaseSha      string     `json:"base_sha"`
+		StartSha     string     `json:"start_sha"`
+		HeadSha      string     `json:"head_sha"`
+		OldPath      string     `json:"old_path"`
+		NewPath      string     `json:"new_path"`
+		PositionType string     `json:"position_type"`
+		OldLine      int        `json:"old_line,omitempty"`
+		NewLine      int        `json:"new_line,omitempty"`
+		LineRange    *LineRange `json:"line_range,omitempty"`
+		Width        int        `json:"width,omitempty"`
+		Height       int        `json:"height,omitempty"`
+		X            int        `json:"x,omitempty"`
+		Y            int        `json:"y,omitempty"`
+	}
+
+	// LineRange entity is a range of lines of multi-line diff note
+	LineRange struct {
+		Start LinePosition `json:"start"`
+		End   LinePosition `json:"end"`
+	}
+
+	// LinePosition entity is a boundary line of LineRange
+	LinePosition struct {
+		LineCode string `json:"line_code"`
+		Type     string `json:"type,omitempty"`
+		OldLine  int    `json:"old_line,omitempty"`
+		NewLine  int    `json:"new_line,omitempty"`
 	}
 )
 
@@ -88,3 +107,38 @@ func getDiscussion(ctx context.Context, c *client, projectID, mrID int, discussi
 
 	return discussion, nil
 }
+
+func listDiscussions(ctx context.Context, c *client, projectID, mrID int, opts ListOptions) ([]Discussion, error) {
+	path := fmt.Sprintf("projects/%d/merge_requests/%d/discussions", projectID, mrID)
+	resp, err := c.get(ctx, withQuery(path, opts.values()))
+	if err != nil {
+		return nil, err
+	}
+
+	var discussions []Discussion
+	if err = json.Unmarshal(resp, &discussions); err != nil {
+		return nil, fmt.Errorf("can't unmarshal discussions data: %w", err)
+	}
+
+	return discussions, nil
+}
+
+// listAllDiscussions walks all pages of merge request discussions
+func listAllDiscussions(ctx context.Context, c *client, projectID, mrID int) ([]Discussion, error) {
+	opts := ListOptions{Page: 1, PerPage: maxPerPage}
+	discussions := make([]Discussion, 0)
+
+	for {
+		page, err := c.ListDiscussions(ctx, projectID, mrID, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		discussions = append(discussions, page...)
+		if len(page) < opts.PerPage {
+			return discussions, nil
+		}
+
+		opts.Page++
+	}
+}
@@ -0,0 +1,66 @@
+// Package gitlab - exchange
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const requestIDHeader = "X-Request-Id"
+
+type (
+	// exchange collects details of a single SendRequest call for logging and instrumentation
+	exchange struct {
+		method             string
+		path               string
+		body               []byte
+		header             http.Header
+		attempts           int
+		status             int
+		requestID          string
+		rateLimitRemaining int
+		start              time.Time
+	}
+
+	exchangeKey struct{}
+)
+
+func newExchange(method string, path string, body []byte) *exchange {
+	return &exchange{method: method, path: path, body: body, rateLimitRemaining: -1, start: time.Now()}
+}
+
+func withExchange(ctx context.Context, ex *exchange) context.Context {
+	return context.WithValue(ctx, exchangeKey{}, ex)
+}
+
+func exchangeFromContext(ctx context.Context) *exchange {
+	ex, _ := ctx.Value(exchangeKey{}).(*exchange)
+	return ex
+}
+
+// retries returns number of http attempts made after the first one
+func (ex *exchange) retries() int {
+	if ex.attempts == 0 {
+		return 0
+	}
+
+	return ex.attempts - 1
+}
+
+// started is called before each http attempt with the request modified by middlewares
+func (ex *exchange) started(req *http.Request) {
+	ex.attempts++
+	ex.header = req.Header.Clone()
+}
+
+// finished is called after each http attempt
+func (ex *exchange) finished(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	ex.status = resp.StatusCode
+	ex.requestID = resp.Header.Get(requestIDHeader)
+	ex.rateLimitRemaining = parseRateLimitRemaining(resp.Header.Get(rateLimitRemainingHeader))
+}
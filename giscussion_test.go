@@ -153,3 +153,47 @@ func TestClient_GetParticipants(t *testing.T) {
 		assert.Equal(t, []gitlab.NoteAuthor(nil), participants)
 	})
 }
+
+func TestClient_ListDiscussions(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/discussions?page=2", req.URL.String())
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`[{"id": "d1"}, {"id": "d2"}]`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		discussions, err := client.Discussions().List(context.Background(), 10, 20, gitlab.ListOptions{Page: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, []gitlab.Discussion{{ID: "d1"}, {ID: "d2"}}, discussions)
+	})
+
+	t.Run("error on unmarshal response", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{"))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		discussions, err := client.Discussions().List(context.Background(), 10, 20, gitlab.ListOptions{})
+		assert.Error(t, err)
+		assert.Equal(t, []gitlab.Discussion(nil), discussions)
+	})
+}
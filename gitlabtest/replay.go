@@ -0,0 +1,363 @@
+// Package gitlabtest - record and replay of http interactions
+package gitlabtest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	// sensitiveHeaders are credentials never written to golden files
+	sensitiveHeaders = []string{"Private-Token", "Job-Token", "Authorization", "Cookie", "Set-Cookie"}
+
+	// sensitiveQueryParams are credentials gitlab accepts in query, they are never written to golden files
+	sensitiveQueryParams = map[string]bool{"private_token": true, "job_token": true, "access_token": true}
+)
+
+type (
+	// Interaction is a request/response pair stored as a single line of golden file
+	Interaction struct {
+		Request  RecordedRequest  `json:"request"`
+		Response RecordedResponse `json:"response"`
+	}
+
+	// RecordedRequest is a request of interaction, it is matched by method, path, query and body
+	RecordedRequest struct {
+		Method string      `json:"method"`
+		Path   string      `json:"path"`
+		Query  string      `json:"query,omitempty"`
+		Header http.Header `json:"header,omitempty"`
+		Body   string      `json:"body,omitempty"`
+	}
+
+	// RecordedResponse is a response of interaction
+	RecordedResponse struct {
+		Status int         `json:"status"`
+		Header http.Header `json:"header,omitempty"`
+		Body   string      `json:"body,omitempty"`
+	}
+
+	// Recorder is http client sending requests by wrapped client and writing interactions to golden file
+	Recorder struct {
+		client gitlab.HTTPClient
+		mu     sync.Mutex
+		w      io.Writer
+	}
+
+	// Replayer is http client serving responses of recorded interactions
+	Replayer struct {
+		mu           sync.Mutex
+		interactions []Interaction
+		used         []bool
+	}
+)
+
+// NewRecorder returns recorder writing interactions to w, credentials are replaced by [REDACTED]
+func NewRecorder(client gitlab.HTTPClient, w io.Writer) *Recorder {
+	return &Recorder{client: client, w: w}
+}
+
+// Do implements gitlab.HTTPClient
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("can't read request body: %w", err)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("can't read response body: %w", err)
+	}
+
+	secrets := append(credentials(req.Header), queryCredentials(req.URL.Query())...)
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  redactQuery(req.URL.RawQuery, secrets),
+			Header: redactHeader(req.Header, secrets),
+			Body:   redact(string(reqBody), secrets),
+		},
+		Response: RecordedResponse{
+			Status: resp.StatusCode,
+			Header: redactHeader(resp.Header, secrets),
+			Body:   redact(string(respBody), secrets),
+		},
+	}
+
+	line, err := json.Marshal(interaction)
+	if err != nil {
+		return nil, fmt.Errorf("can't marshal interaction: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err = r.w.Write(append(line, '\n')); err != nil {
+		return nil, fmt.Errorf("can't write interaction: %w", err)
+	}
+
+	return resp, nil
+}
+
+// NewReplayer returns replayer of interactions read from golden file content
+func NewReplayer(r io.Reader) (*Replayer, error) {
+	replayer := &Replayer{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64*1024*1024)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var interaction Interaction
+		if err := json.Unmarshal(line, &interaction); err != nil {
+			return nil, fmt.Errorf("can't unmarshal interaction: %w", err)
+		}
+
+		replayer.interactions = append(replayer.interactions, interaction)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("can't read interactions: %w", err)
+	}
+
+	replayer.used = make([]bool, len(replayer.interactions))
+
+	return replayer, nil
+}
+
+// LoadReplayer returns replayer of interactions from golden file
+func LoadReplayer(filename string) (*Replayer, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("can't open golden file: %w", err)
+	}
+	defer file.Close()
+
+	return NewReplayer(file)
+}
+
+// Do implements gitlab.HTTPClient, matching interactions are served in recorded order,
+// the last one is repeated when all of them are used
+func (r *Replayer) Do(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("can't read request body: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	found := -1
+	for i, interaction := range r.interactions {
+		if !interaction.Request.matches(req, body) {
+			continue
+		}
+
+		found = i
+		if !r.used[i] {
+			break
+		}
+	}
+
+	if found < 0 {
+		return nil, fmt.Errorf("no recorded interaction for %s %s", req.Method, req.URL.RequestURI())
+	}
+
+	r.used[found] = true
+	recorded := r.interactions[found].Response
+
+	header := http.Header{}
+	for name, values := range recorded.Header {
+		header[name] = append([]string(nil), values...)
+	}
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode: recorded.Status,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader(recorded.Body)),
+		Request:    req,
+	}, nil
+}
+
+// Unused returns interactions which were never replayed
+func (r *Replayer) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var res []Interaction
+	for i, used := range r.used {
+		if !used {
+			res = append(res, r.interactions[i])
+		}
+	}
+
+	return res
+}
+
+func (rr RecordedRequest) matches(req *http.Request, body []byte) bool {
+	if rr.Method != req.Method || rr.Path != req.URL.Path {
+		return false
+	}
+
+	if rr.Query != req.URL.RawQuery && !sameQuery(rr.Query, req.URL.RawQuery) {
+		return false
+	}
+
+	return rr.Body == string(body) || sameJSON(rr.Body, body)
+}
+
+// sameQuery compares queries regardless of parameters order, redacted values match any value
+func sameQuery(recorded string, actual string) bool {
+	rq, err := url.ParseQuery(recorded)
+	if err != nil {
+		return false
+	}
+
+	aq, err := url.ParseQuery(actual)
+	if err != nil || len(rq) != len(aq) {
+		return false
+	}
+
+	for name, values := range rq {
+		if len(values) == 1 && values[0] == redacted {
+			if _, has := aq[name]; has {
+				continue
+			}
+		}
+
+		if !reflect.DeepEqual(values, aq[name]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameJSON(recorded string, actual []byte) bool {
+	var rv, av interface{}
+	if json.Unmarshal([]byte(recorded), &rv) != nil || json.Unmarshal(actual, &av) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(rv, av)
+}
+
+// readBody reads body and replaces it by a copy
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+
+	*body = ioutil.NopCloser(bytes.NewReader(data))
+
+	return data, nil
+}
+
+// credentials returns values of sensitive headers to hide them in any part of interaction
+func credentials(header http.Header) []string {
+	var res []string
+	for _, name := range sensitiveHeaders {
+		for _, value := range header.Values(name) {
+			res = append(res, value)
+			if fields := strings.Fields(value); len(fields) == 2 {
+				res = append(res, fields[1])
+			}
+		}
+	}
+
+	return res
+}
+
+// queryCredentials returns values of sensitive query parameters to hide them in any part of interaction
+func queryCredentials(query url.Values) []string {
+	var res []string
+	for name, values := range query {
+		if sensitiveQueryParams[strings.ToLower(name)] {
+			res = append(res, values...)
+		}
+	}
+
+	return res
+}
+
+// redactQuery replaces values of sensitive query parameters regardless of their encoding and hides secrets in others
+func redactQuery(query string, secrets []string) string {
+	if query == "" {
+		return ""
+	}
+
+	params := strings.Split(query, "&")
+	for i, param := range params {
+		key := strings.SplitN(param, "=", 2)[0]
+		if unescaped, err := url.QueryUnescape(key); err == nil && sensitiveQueryParams[strings.ToLower(unescaped)] {
+			params[i] = key + "=" + redacted
+			continue
+		}
+
+		params[i] = redact(param, secrets)
+	}
+
+	return strings.Join(params, "&")
+}
+
+func redact(value string, secrets []string) string {
+	for _, secret := range secrets {
+		if secret != "" {
+			value = strings.ReplaceAll(value, secret, redacted)
+		}
+	}
+
+	return value
+}
+
+func redactHeader(header http.Header, secrets []string) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+
+	res := http.Header{}
+	for name, values := range header {
+		for _, value := range values {
+			res.Add(name, redact(value, secrets))
+		}
+	}
+
+	for _, name := range sensitiveHeaders {
+		if _, has := res[http.CanonicalHeaderKey(name)]; has {
+			res.Set(name, redacted)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,116 @@
+package gitlabtest_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-gitlab"
+	"github.com/kryabinin/go-gitlab/gitlabtest"
+)
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+
+	server := gitlabtest.NewServer()
+	defer server.Close()
+
+	server.AddUser(gitlab.User{ID: 1, Name: "John"})
+	server.AddProject(gitlabtest.Project{ID: 10})
+	_, err := server.AddMergeRequest(10, gitlab.MergeRequest{Title: "Add feature"})
+	assert.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "gitlabtest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	golden := filepath.Join(dir, "interactions.jsonl")
+
+	var buf bytes.Buffer
+	recorder := gitlabtest.NewRecorder(http.DefaultClient, &buf)
+	client := server.Client(gitlab.WithHttpClient(recorder))
+
+	user, err := client.GetUserByID(ctx, 1)
+	assert.NoError(t, err)
+
+	_, err = client.SendRequest(ctx, http.MethodPost, "projects/10/merge_requests/1/discussions", []byte(`{"body": "LGTM"}`))
+	assert.NoError(t, err)
+
+	_, err = client.Discussions().List(ctx, 10, 1, gitlab.ListOptions{Page: 1, PerPage: 50})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, strings.Count(buf.String(), "\n"))
+	assert.NotContains(t, buf.String(), gitlabtest.DefaultToken)
+	assert.Contains(t, buf.String(), `"Private-Token":["[REDACTED]"]`)
+	assert.NoError(t, ioutil.WriteFile(golden, buf.Bytes(), 0644))
+
+	t.Run("replay", func(t *testing.T) {
+		replayer, err := gitlabtest.LoadReplayer(golden)
+		assert.NoError(t, err)
+
+		client := gitlab.NewClient("other_token", gitlab.WithBaseUrl(server.URL()), gitlab.WithHttpClient(replayer))
+
+		replayed, err := client.GetUserByID(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, user, replayed)
+
+		_, err = client.SendRequest(ctx, http.MethodPost, "projects/10/merge_requests/1/discussions", []byte(`{ "body":"LGTM" }`))
+		assert.NoError(t, err)
+
+		discussions, err := client.Discussions().List(ctx, 10, 1, gitlab.ListOptions{PerPage: 50, Page: 1})
+		assert.NoError(t, err)
+		assert.Len(t, discussions, 1)
+
+		assert.Empty(t, replayer.Unused())
+	})
+
+	t.Run("not recorded", func(t *testing.T) {
+		replayer, err := gitlabtest.NewReplayer(&buf)
+		assert.NoError(t, err)
+
+		client := gitlab.NewClient("test_token", gitlab.WithBaseUrl(server.URL()), gitlab.WithHttpClient(replayer))
+
+		_, err = client.GetUserByID(ctx, 2)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "no recorded interaction for GET /api/v4//users/2")
+	})
+
+	t.Run("credentials in query", func(t *testing.T) {
+		var buf bytes.Buffer
+		recorder := gitlabtest.NewRecorder(gitlab.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"version": "15.0.0"}`)),
+			}, nil
+		}), &buf)
+
+		client := gitlab.NewClient("", gitlab.WithBaseUrl(server.URL()), gitlab.WithHttpClient(recorder))
+
+		query := "version?private_token=secret-1&job_token=secret%2B2&ACCESS_TOKEN=secret-3&scope=all"
+		_, err := client.SendRequest(ctx, http.MethodGet, query, nil)
+		assert.NoError(t, err)
+
+		assert.NotContains(t, buf.String(), "secret")
+
+		var interaction gitlabtest.Interaction
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &interaction))
+		assert.Equal(t, "private_token=[REDACTED]&job_token=[REDACTED]&ACCESS_TOKEN=[REDACTED]&scope=all",
+			interaction.Request.Query)
+
+		replayer, err := gitlabtest.NewReplayer(&buf)
+		assert.NoError(t, err)
+
+		client = gitlab.NewClient("", gitlab.WithBaseUrl(server.URL()), gitlab.WithHttpClient(replayer))
+
+		_, err = client.SendRequest(ctx, http.MethodGet, "version?private_token=other&job_token=other&ACCESS_TOKEN=other&scope=all", nil)
+		assert.NoError(t, err)
+	})
+}
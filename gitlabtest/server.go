@@ -0,0 +1,631 @@
+// Package gitlabtest provides in-process fake gitlab server to test code using gitlab client offline
+package gitlabtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+// DefaultToken is a token accepted by server if other is not set by WithToken
+const DefaultToken = "gitlabtest-token"
+
+// DefaultVersion is a gitlab version reported by server if other is not set by WithVersion
+const DefaultVersion = "16.0.0"
+
+const (
+	apiPrefix       = "/api/v4"
+	defaultPerPage  = 20
+	maxPerPage      = 100
+	jsonContentType = "application/json"
+)
+
+type (
+	// Server is a fake gitlab api emulating users, projects, merge requests and discussions with in-memory store
+	Server struct {
+		server  *httptest.Server
+		token   string
+		version string
+
+		mu               sync.Mutex
+		users            map[int]gitlab.User
+		projects         map[int]Project
+		mergeRequests    map[mergeRequestKey]*mergeRequest
+		failures         []*Failure
+		requests         []Request
+		lastMergeID      int
+		lastDiscussionID int
+		lastNoteID       int
+	}
+
+	// Project entity stored by server
+	Project struct {
+		ID                int    `json:"id"`
+		Name              string `json:"name"`
+		PathWithNamespace string `json:"path_with_namespace"`
+		DefaultBranch     string `json:"default_branch"`
+		WebUrl            string `json:"web_url"`
+	}
+
+	// Failure describes error injected into server responses
+	Failure struct {
+		// Method of failed requests, any method if empty
+		Method string
+		// Endpoint is a path template of failed requests as returned by gitlab.Endpoint, any path if empty
+		Endpoint string
+		// Status is a status code of response
+		Status int
+		// Times is a number of failed requests, failure is permanent if zero
+		Times int
+	}
+
+	// Request is a request received by server
+	Request struct {
+		Method string
+		Path   string
+		Query  string
+		// Sudo is a user the request is performed as
+		Sudo string
+	}
+
+	// Option to use optional parameters of server
+	Option interface {
+		apply(s *Server)
+	}
+
+	withToken struct {
+		token string
+	}
+
+	withVersion struct {
+		version string
+	}
+
+	mergeRequestKey struct {
+		projectID int
+		iid       int
+	}
+
+	mergeRequest struct {
+		gitlab.MergeRequest
+		approvedBy  []gitlab.Approver
+		discussions []gitlab.Discussion
+	}
+
+	route struct {
+		method  string
+		pattern []string
+		handler func(w http.ResponseWriter, r *http.Request, params []string)
+	}
+)
+
+// WithToken replaces default accepted token
+func WithToken(token string) Option {
+	return withToken{token: token}
+}
+
+func (opt withToken) apply(s *Server) {
+	s.token = opt.token
+}
+
+// WithVersion replaces default gitlab version, metadata endpoint is absent in versions before 15.2
+func WithVersion(version string) Option {
+	return withVersion{version: version}
+}
+
+func (opt withVersion) apply(s *Server) {
+	s.version = opt.version
+}
+
+// NewServer starts fake gitlab server, it has to be closed by Close
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		token:         DefaultToken,
+		version:       DefaultVersion,
+		users:         map[int]gitlab.User{},
+		projects:      map[int]Project{},
+		mergeRequests: map[mergeRequestKey]*mergeRequest{},
+	}
+
+	for _, opt := range opts {
+		opt.apply(s)
+	}
+
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Close shuts down server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns base api url of server
+func (s *Server) URL() string {
+	return s.server.URL + apiPrefix
+}
+
+// Client returns gitlab client configured to use server, options are applied after base url and token ones
+func (s *Server) Client(opts ...gitlab.ClientOption) gitlab.Client {
+	return gitlab.NewClient(s.token, append([]gitlab.ClientOption{gitlab.WithBaseUrl(s.URL())}, opts...)...)
+}
+
+// AddUser stores user
+func (s *Server) AddUser(user gitlab.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.users[user.ID] = user
+}
+
+// AddProject stores project
+func (s *Server) AddProject(project Project) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.projects[project.ID] = project
+}
+
+// AddMergeRequest stores merge request of existing project, missing ID and IID are generated
+func (s *Server) AddMergeRequest(projectID int, mr gitlab.MergeRequest) (gitlab.MergeRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, has := s.projects[projectID]; !has {
+		return gitlab.MergeRequest{}, fmt.Errorf("project %d not found", projectID)
+	}
+
+	s.lastMergeID++
+	if mr.ID == 0 {
+		mr.ID = s.lastMergeID
+	}
+
+	if mr.IID == 0 {
+		for _, other := range s.mergeRequests {
+			if other.ProjectID == projectID && other.IID > mr.IID {
+				mr.IID = other.IID
+			}
+		}
+		mr.IID++
+	}
+
+	if mr.State == "" {
+		mr.State = "opened"
+	}
+
+	mr.ProjectID = projectID
+	s.mergeRequests[mergeRequestKey{projectID: projectID, iid: mr.IID}] = &mergeRequest{MergeRequest: mr}
+
+	return mr, nil
+}
+
+// AddDiscussion stores discussion of existing merge request, missing discussion and note ids are generated
+func (s *Server) AddDiscussion(projectID, mrIID int, discussion gitlab.Discussion) (gitlab.Discussion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mr, has := s.mergeRequests[mergeRequestKey{projectID: projectID, iid: mrIID}]
+	if !has {
+		return gitlab.Discussion{}, fmt.Errorf("merge request %d of project %d not found", mrIID, projectID)
+	}
+
+	s.fillDiscussion(mr, &discussion)
+	mr.discussions = append(mr.discussions, discussion)
+
+	return discussion, nil
+}
+
+// Approve adds approval of existing merge request by user
+func (s *Server) Approve(projectID, mrIID int, user gitlab.NoteAuthor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mr, has := s.mergeRequests[mergeRequestKey{projectID: projectID, iid: mrIID}]
+	if !has {
+		return fmt.Errorf("merge request %d of project %d not found", mrIID, projectID)
+	}
+
+	mr.approvedBy = append(mr.approvedBy, gitlab.Approver{User: user})
+
+	return nil
+}
+
+// InjectFailure makes matching requests fail with status of failure
+func (s *Server) InjectFailure(failure Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, &failure)
+}
+
+// Requests returns requests received by server
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *Server) fillDiscussion(mr *mergeRequest, discussion *gitlab.Discussion) {
+	s.lastDiscussionID++
+	if discussion.ID == "" {
+		discussion.ID = fmt.Sprintf("%040x", s.lastDiscussionID)
+	}
+
+	for i := range discussion.Notes {
+		note := &discussion.Notes[i]
+
+		s.lastNoteID++
+		if note.ID == 0 {
+			note.ID = s.lastNoteID
+		}
+
+		if note.CreatedAt == nil {
+			note.CreatedAt = &gitlab.Time{Time: time.Now().UTC()}
+		}
+
+		note.NoteableID = mr.ID
+		note.NoteableIID = mr.IID
+		note.NoteableType = "MergeRequest"
+	}
+}
+
+func (s *Server) routes() []route {
+	return []route{
+		{http.MethodGet, []string{"version"}, s.getVersion},
+		{http.MethodGet, []string{"metadata"}, s.getMetadata},
+		{http.MethodGet, []string{"users", ":id"}, s.getUser},
+		{http.MethodGet, []string{"projects", ":id"}, s.getProject},
+		{http.MethodGet, []string{"projects", ":id", "merge_requests"}, s.listMergeRequests},
+		{http.MethodGet, []string{"projects", ":id", "merge_requests", ":iid"}, s.getMergeRequest},
+		{http.MethodGet, []string{"projects", ":id", "merge_requests", ":iid", "approvals"}, s.getApprovals},
+		{http.MethodGet, []string{"projects", ":id", "merge_requests", ":iid", "discussions"}, s.listDiscussions},
+		{http.MethodPost, []string{"projects", ":id", "merge_requests", ":iid", "discussions"}, s.createDiscussion},
+		{http.MethodGet, []string{"projects", ":id", "merge_requests", ":iid", "discussions", ":id"}, s.getDiscussion},
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, apiPrefix)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   path,
+		Query:  r.URL.RawQuery,
+		Sudo:   r.Header.Get("Sudo"),
+	})
+	s.mu.Unlock()
+
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "401 Unauthorized")
+		return
+	}
+
+	if status, failed := s.failure(r.Method, path); failed {
+		writeError(w, status, strconv.Itoa(status)+" "+http.StatusText(status))
+		return
+	}
+
+	segments := splitPath(path)
+	for _, rt := range s.routes() {
+		if params, ok := rt.match(r.Method, segments); ok {
+			rt.handler(w, r, params)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "404 Not Found")
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	if token := r.Header.Get("Private-Token"); token != "" {
+		return token == s.token
+	}
+
+	if token := r.Header.Get("Job-Token"); token != "" {
+		return token == s.token
+	}
+
+	return r.Header.Get("Authorization") == "Bearer "+s.token
+}
+
+func (s *Server) failure(method string, path string) (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	endpoint := gitlab.Endpoint(path)
+	for i, failure := range s.failures {
+		if failure.Method != "" && failure.Method != method {
+			continue
+		}
+
+		if failure.Endpoint != "" && strings.Trim(failure.Endpoint, "/") != endpoint {
+			continue
+		}
+
+		if failure.Times > 0 {
+			failure.Times--
+			if failure.Times == 0 {
+				s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			}
+		}
+
+		return failure.Status, true
+	}
+
+	return 0, false
+}
+
+func (s *Server) getUser(w http.ResponseWriter, _ *http.Request, params []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, has := s.users[atoi(params[0])]
+	if !has {
+		writeError(w, http.StatusNotFound, "404 User Not Found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) getVersion(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, gitlab.Version{Version: s.version, Revision: "gitlabtest"})
+}
+
+func (s *Server) getMetadata(w http.ResponseWriter, _ *http.Request, _ []string) {
+	if !(gitlab.Version{Version: s.version}).AtLeast("15.2") {
+		writeError(w, http.StatusNotFound, "404 Not Found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, gitlab.Metadata{Version: s.version, Revision: "gitlabtest"})
+}
+
+func (s *Server) getProject(w http.ResponseWriter, _ *http.Request, params []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	project, has := s.projects[atoi(params[0])]
+	if !has {
+		writeError(w, http.StatusNotFound, "404 Project Not Found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, project)
+}
+
+func (s *Server) listMergeRequests(w http.ResponseWriter, r *http.Request, params []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	projectID := atoi(params[0])
+	if _, has := s.projects[projectID]; !has {
+		writeError(w, http.StatusNotFound, "404 Project Not Found")
+		return
+	}
+
+	state := r.URL.Query().Get("state")
+
+	mrs := make([]gitlab.MergeRequest, 0)
+	for key, mr := range s.mergeRequests {
+		if key.projectID == projectID && (state == "" || state == "all" || state == mr.State) {
+			mrs = append(mrs, mr.MergeRequest)
+		}
+	}
+
+	sort.Slice(mrs, func(i, j int) bool { return mrs[i].IID > mrs[j].IID })
+
+	start, end := paginate(w, r, len(mrs))
+	writeJSON(w, http.StatusOK, mrs[start:end])
+}
+
+func (s *Server) getMergeRequest(w http.ResponseWriter, _ *http.Request, params []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mr, ok := s.findMergeRequest(w, params)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mr.MergeRequest)
+}
+
+func (s *Server) getApprovals(w http.ResponseWriter, _ *http.Request, params []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mr, ok := s.findMergeRequest(w, params)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, gitlab.MergeRequestApprovals{
+		ID:         mr.ID,
+		IID:        mr.IID,
+		ProjectID:  mr.ProjectID,
+		Title:      mr.Title,
+		State:      mr.State,
+		Approved:   len(mr.approvedBy) > 0,
+		ApprovedBy: append([]gitlab.Approver{}, mr.approvedBy...),
+	})
+}
+
+func (s *Server) listDiscussions(w http.ResponseWriter, r *http.Request, params []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mr, ok := s.findMergeRequest(w, params)
+	if !ok {
+		return
+	}
+
+	start, end := paginate(w, r, len(mr.discussions))
+	writeJSON(w, http.StatusOK, append([]gitlab.Discussion{}, mr.discussions[start:end]...))
+}
+
+func (s *Server) createDiscussion(w http.ResponseWriter, r *http.Request, params []string) {
+	var data struct {
+		Body     string           `json:"body"`
+		Position *gitlab.Position `json:"position"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil || data.Body == "" {
+		writeError(w, http.StatusBadRequest, "400 Bad request - body is missing")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mr, ok := s.findMergeRequest(w, params)
+	if !ok {
+		return
+	}
+
+	note := gitlab.Note{Body: data.Body, Type: "DiscussionNote"}
+	if data.Position != nil {
+		note.Type, note.Position, note.Resolvable = "DiffNote", *data.Position, true
+	}
+
+	discussion := gitlab.Discussion{Notes: []gitlab.Note{note}}
+	s.fillDiscussion(mr, &discussion)
+	mr.discussions = append(mr.discussions, discussion)
+
+	writeJSON(w, http.StatusCreated, discussion)
+}
+
+func (s *Server) getDiscussion(w http.ResponseWriter, _ *http.Request, params []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mr, ok := s.findMergeRequest(w, params)
+	if !ok {
+		return
+	}
+
+	for _, discussion := range mr.discussions {
+		if discussion.ID == params[2] {
+			writeJSON(w, http.StatusOK, discussion)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "404 Discussion Not Found")
+}
+
+// findMergeRequest returns merge request by project id and iid params or writes not found error
+func (s *Server) findMergeRequest(w http.ResponseWriter, params []string) (*mergeRequest, bool) {
+	mr, has := s.mergeRequests[mergeRequestKey{projectID: atoi(params[0]), iid: atoi(params[1])}]
+	if !has {
+		writeError(w, http.StatusNotFound, "404 Merge Request Not Found")
+		return nil, false
+	}
+
+	return mr, true
+}
+
+func (rt route) match(method string, segments []string) ([]string, bool) {
+	if rt.method != method || len(rt.pattern) != len(segments) {
+		return nil, false
+	}
+
+	var params []string
+	for i, segment := range rt.pattern {
+		switch {
+		case strings.HasPrefix(segment, ":"):
+			params = append(params, segments[i])
+		case segment != segments[i]:
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+// paginate writes pagination headers and returns bounds of requested page
+func paginate(w http.ResponseWriter, r *http.Request, total int) (int, int) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+
+	if perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+
+	totalPages := (total + perPage - 1) / perPage
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	header := w.Header()
+	header.Set("X-Page", strconv.Itoa(page))
+	header.Set("X-Per-Page", strconv.Itoa(perPage))
+	header.Set("X-Total", strconv.Itoa(total))
+	header.Set("X-Total-Pages", strconv.Itoa(totalPages))
+	header.Set("X-Next-Page", "")
+	header.Set("X-Prev-Page", "")
+
+	if page < totalPages {
+		header.Set("X-Next-Page", strconv.Itoa(page+1))
+	}
+
+	if page > 1 {
+		header.Set("X-Prev-Page", strconv.Itoa(page-1))
+	}
+
+	start := (page - 1) * perPage
+	if start > total {
+		start = total
+	}
+
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+
+	return start, end
+}
+
+func splitPath(path string) []string {
+	segments := make([]string, 0)
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	return segments
+}
+
+func atoi(value string) int {
+	res, _ := strconv.Atoi(value)
+	return res
+}
+
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", jsonContentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
@@ -0,0 +1,142 @@
+package gitlabtest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-gitlab"
+	"github.com/kryabinin/go-gitlab/gitlabtest"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+
+	newServer := func(t *testing.T) *gitlabtest.Server {
+		server := gitlabtest.NewServer()
+		t.Cleanup(server.Close)
+
+		server.AddUser(gitlab.User{ID: 1, Name: "John", UserName: "john"})
+		server.AddUser(gitlab.User{ID: 2, Name: "Jane", UserName: "jane"})
+		server.AddProject(gitlabtest.Project{ID: 10, Name: "project"})
+
+		_, err := server.AddMergeRequest(10, gitlab.MergeRequest{
+			Title:  "Add feature",
+			Author: gitlab.NoteAuthor{ID: 1, UserName: "john"},
+		})
+		assert.NoError(t, err)
+
+		return server
+	}
+
+	t.Run("users", func(t *testing.T) {
+		server := newServer(t)
+		client := server.Client()
+
+		user, err := client.GetUserByID(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.User{ID: 1, Name: "John", UserName: "john"}, user)
+
+		users, err := client.GetUsersByIDs(ctx, []int{1, 2})
+		assert.NoError(t, err)
+		assert.Len(t, users, 2)
+
+		_, err = client.GetUserByID(ctx, 3)
+		assert.Error(t, err)
+	})
+
+	t.Run("merge request participants", func(t *testing.T) {
+		server := newServer(t)
+
+		for i := 0; i < 150; i++ {
+			_, err := server.AddDiscussion(10, 1, gitlab.Discussion{Notes: []gitlab.Note{
+				{Body: "comment", Author: gitlab.NoteAuthor{ID: 2, UserName: "jane"}},
+			}})
+			assert.NoError(t, err)
+		}
+		assert.NoError(t, server.Approve(10, 1, gitlab.NoteAuthor{ID: 2, UserName: "jane"}))
+
+		participants, err := server.Client().MergeRequests().GetParticipants(ctx, 10, 1, gitlab.ParticipantsReportOptions{})
+		assert.NoError(t, err)
+
+		if assert.Len(t, participants, 2) {
+			assert.True(t, participants[0].HasRole(gitlab.RoleAuthor))
+			assert.True(t, participants[1].HasRole(gitlab.RoleApprover))
+			assert.Equal(t, 150, participants[1].Notes)
+		}
+
+		var pages []string
+		for _, req := range server.Requests() {
+			if strings.HasSuffix(req.Path, "/discussions") {
+				pages = append(pages, req.Query)
+			}
+		}
+		assert.Equal(t, []string{"page=1&per_page=100", "page=2&per_page=100"}, pages)
+	})
+
+	t.Run("discussions", func(t *testing.T) {
+		server := newServer(t)
+		client := server.Client()
+
+		_, err := client.SendRequest(ctx, http.MethodPost, "projects/10/merge_requests/1/discussions", []byte(`{"body": "LGTM"}`))
+		assert.NoError(t, err)
+
+		discussions, err := client.Discussions().List(ctx, 10, 1, gitlab.ListOptions{})
+		assert.NoError(t, err)
+
+		if assert.Len(t, discussions, 1) {
+			discussion, err := client.GetDiscussion(ctx, 10, 1, discussions[0].ID)
+			assert.NoError(t, err)
+			assert.Equal(t, "LGTM", discussion.Notes[0].Body)
+			assert.Equal(t, 1, discussion.Notes[0].NoteableIID)
+		}
+	})
+
+	t.Run("version", func(t *testing.T) {
+		client := newServer(t).Client()
+
+		version, err := client.Instance().Version(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, gitlabtest.DefaultVersion, version.Version)
+
+		metadata, err := client.Instance().Metadata(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, gitlabtest.DefaultVersion, metadata.Version)
+
+		server := gitlabtest.NewServer(gitlabtest.WithVersion("14.0.0"))
+		defer server.Close()
+
+		_, err = server.Client().Instance().Metadata(ctx)
+		assert.True(t, errors.Is(err, gitlab.ErrUnsupported))
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		server := newServer(t)
+		client := gitlab.NewClient("wrong_token", gitlab.WithBaseUrl(server.URL()))
+
+		_, err := client.GetUserByID(ctx, 1)
+		assert.EqualError(t, err, "gitlab respond with 401 status code")
+	})
+
+	t.Run("injected failure", func(t *testing.T) {
+		server := newServer(t)
+		server.InjectFailure(gitlabtest.Failure{
+			Method:   http.MethodGet,
+			Endpoint: "users/:id",
+			Status:   http.StatusServiceUnavailable,
+			Times:    1,
+		})
+
+		client := server.Client()
+
+		_, err := client.GetUserByID(ctx, 1)
+		assert.EqualError(t, err, "gitlab respond with 503 status code")
+
+		_, err = client.GetUserByID(ctx, 1)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,11 @@
+// Package gitlab - group
+package gitlab
+
+// Group entity
+type Group struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	FullPath string `json:"full_path"`
+	WebUrl   string `json:"web_url"`
+}
@@ -0,0 +1,225 @@
+// Package gitlab - project, group and system hooks
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// HookTrigger is an event to trigger on hook testing
+type HookTrigger string
+
+// Hook triggers
+const (
+	HookTriggerPush               HookTrigger = "push_events"
+	HookTriggerTagPush            HookTrigger = "tag_push_events"
+	HookTriggerIssues             HookTrigger = "issues_events"
+	HookTriggerConfidentialIssues HookTrigger = "confidential_issues_events"
+	HookTriggerNote               HookTrigger = "note_events"
+	HookTriggerMergeRequests      HookTrigger = "merge_requests_events"
+	HookTriggerJob                HookTrigger = "job_events"
+	HookTriggerPipeline           HookTrigger = "pipeline_events"
+	HookTriggerWikiPage           HookTrigger = "wiki_page_events"
+	HookTriggerReleases           HookTrigger = "releases_events"
+	HookTriggerDeployment         HookTrigger = "deployment_events"
+	HookTriggerConfidentialNote   HookTrigger = "confidential_note_events"
+	HookTriggerRepositoryUpdate   HookTrigger = "repository_update_events"
+)
+
+type (
+	// Hook (project or group webhook) entity, ProjectID is set for project hooks and GroupID for group ones
+	Hook struct {
+		ID                       int               `json:"id"`
+		Url                      string            `json:"url"`
+		ProjectID                int               `json:"project_id"`
+		GroupID                  int               `json:"group_id"`
+		PushEvents               bool              `json:"push_events"`
+		PushEventsBranchFilter   string            `json:"push_events_branch_filter"`
+		TagPushEvents            bool              `json:"tag_push_events"`
+		IssuesEvents             bool              `json:"issues_events"`
+		ConfidentialIssuesEvents bool              `json:"confidential_issues_events"`
+		MergeRequestsEvents      bool              `json:"merge_requests_events"`
+		NoteEvents               bool              `json:"note_events"`
+		ConfidentialNoteEvents   bool              `json:"confidential_note_events"`
+		JobEvents                bool              `json:"job_events"`
+		PipelineEvents           bool              `json:"pipeline_events"`
+		WikiPageEvents           bool              `json:"wiki_page_events"`
+		DeploymentEvents         bool              `json:"deployment_events"`
+		ReleasesEvents           bool              `json:"releases_events"`
+		EnableSslVerification    bool              `json:"enable_ssl_verification"`
+		UrlVariables             []HookUrlVariable `json:"url_variables"`
+		CreatedAt                *Time             `json:"created_at"`
+	}
+
+	// HookUrlVariable entity, values of url variables are never returned by gitlab
+	HookUrlVariable struct {
+		Key string `json:"key"`
+	}
+
+	// HookOptions contains parameters of project and group hook creation and edit requests
+	HookOptions struct {
+		Url                      string  `json:"url,omitempty"`
+		Token                    string  `json:"token,omitempty"`
+		PushEvents               *bool   `json:"push_events,omitempty"`
+		PushEventsBranchFilter   *string `json:"push_events_branch_filter,omitempty"`
+		TagPushEvents            *bool   `json:"tag_push_events,omitempty"`
+		IssuesEvents             *bool   `json:"issues_events,omitempty"`
+		ConfidentialIssuesEvents *bool   `json:"confidential_issues_events,omitempty"`
+		MergeRequestsEvents      *bool   `json:"merge_requests_events,omitempty"`
+		NoteEvents               *bool   `json:"note_events,omitempty"`
+		ConfidentialNoteEvents   *bool   `json:"confidential_note_events,omitempty"`
+		JobEvents                *bool   `json:"job_events,omitempty"`
+		PipelineEvents           *bool   `json:"pipeline_events,omitempty"`
+		WikiPageEvents           *bool   `json:"wiki_page_events,omitempty"`
+		DeploymentEvents         *bool   `json:"deployment_events,omitempty"`
+		ReleasesEvents           *bool   `json:"releases_events,omitempty"`
+		EnableSslVerification    *bool   `json:"enable_ssl_verification,omitempty"`
+	}
+
+	// SystemHook entity
+	SystemHook struct {
+		ID                     int    `json:"id"`
+		Url                    string `json:"url"`
+		PushEvents             bool   `json:"push_events"`
+		TagPushEvents          bool   `json:"tag_push_events"`
+		MergeRequestsEvents    bool   `json:"merge_requests_events"`
+		RepositoryUpdateEvents bool   `json:"repository_update_events"`
+		EnableSslVerification  bool   `json:"enable_ssl_verification"`
+		CreatedAt              *Time  `json:"created_at"`
+	}
+
+	// SystemHookOptions contains parameters of system hook creation request
+	SystemHookOptions struct {
+		Url                    string `json:"url"`
+		Token                  string `json:"token,omitempty"`
+		PushEvents             *bool  `json:"push_events,omitempty"`
+		TagPushEvents          *bool  `json:"tag_push_events,omitempty"`
+		MergeRequestsEvents    *bool  `json:"merge_requests_events,omitempty"`
+		RepositoryUpdateEvents *bool  `json:"repository_update_events,omitempty"`
+		EnableSslVerification  *bool  `json:"enable_ssl_verification,omitempty"`
+	}
+)
+
+func projectHooksPath(projectID int) string {
+	return fmt.Sprintf("projects/%d/hooks", projectID)
+}
+
+func groupHooksPath(groupID int) string {
+	return fmt.Sprintf("groups/%d/hooks", groupID)
+}
+
+func listHooks(ctx context.Context, c *client, hooksPath string, opts ListOptions) ([]Hook, error) {
+	resp, err := c.get(ctx, withQuery(hooksPath, opts.values()))
+	if err != nil {
+		return nil, err
+	}
+
+	var hooks []Hook
+	if err = json.Unmarshal(resp, &hooks); err != nil {
+		return nil, fmt.Errorf("can't unmarshal hooks data: %w", err)
+	}
+
+	return hooks, nil
+}
+
+func getHook(ctx context.Context, c *client, hooksPath string, hookID int) (Hook, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("%s/%d", hooksPath, hookID))
+	if err != nil {
+		return Hook{}, err
+	}
+
+	var hook Hook
+	if err = json.Unmarshal(resp, &hook); err != nil {
+		return Hook{}, fmt.Errorf("can't unmarshal hook data: %w", err)
+	}
+
+	return hook, nil
+}
+
+func sendHook(
+	ctx context.Context,
+	send func(ctx context.Context, path string, data interface{}) ([]byte, error),
+	path string,
+	opts HookOptions,
+) (Hook, error) {
+	resp, err := send(ctx, path, opts)
+	if err != nil {
+		return Hook{}, err
+	}
+
+	var hook Hook
+	if err = json.Unmarshal(resp, &hook); err != nil {
+		return Hook{}, fmt.Errorf("can't unmarshal hook data: %w", err)
+	}
+
+	return hook, nil
+}
+
+func addHook(ctx context.Context, c *client, hooksPath string, opts HookOptions) (Hook, error) {
+	return sendHook(ctx, c.post, hooksPath, opts)
+}
+
+func editHook(ctx context.Context, c *client, hooksPath string, hookID int, opts HookOptions) (Hook, error) {
+	return sendHook(ctx, c.put, fmt.Sprintf("%s/%d", hooksPath, hookID), opts)
+}
+
+func deleteHook(ctx context.Context, c *client, hooksPath string, hookID int) error {
+	return c.delete(ctx, fmt.Sprintf("%s/%d", hooksPath, hookID))
+}
+
+func testHook(ctx context.Context, c *client, hooksPath string, hookID int, trigger HookTrigger) error {
+	_, err := c.post(ctx, fmt.Sprintf("%s/%d/test/%s", hooksPath, hookID, trigger), nil)
+	return err
+}
+
+func setHookUrlVariable(ctx context.Context, c *client, hooksPath string, hookID int, key, value string) error {
+	data := struct {
+		Value string `json:"value"`
+	}{Value: value}
+
+	_, err := c.put(ctx, fmt.Sprintf("%s/%d/url_variables/%s", hooksPath, hookID, url.PathEscape(key)), data)
+	return err
+}
+
+func deleteHookUrlVariable(ctx context.Context, c *client, hooksPath string, hookID int, key string) error {
+	return c.delete(ctx, fmt.Sprintf("%s/%d/url_variables/%s", hooksPath, hookID, url.PathEscape(key)))
+}
+
+func listSystemHooks(ctx context.Context, c *client, opts ListOptions) ([]SystemHook, error) {
+	resp, err := c.get(ctx, withQuery("hooks", opts.values()))
+	if err != nil {
+		return nil, err
+	}
+
+	var hooks []SystemHook
+	if err = json.Unmarshal(resp, &hooks); err != nil {
+		return nil, fmt.Errorf("can't unmarshal system hooks data: %w", err)
+	}
+
+	return hooks, nil
+}
+
+func addSystemHook(ctx context.Context, c *client, opts SystemHookOptions) (SystemHook, error) {
+	resp, err := c.post(ctx, "hooks", opts)
+	if err != nil {
+		return SystemHook{}, err
+	}
+
+	var hook SystemHook
+	if err = json.Unmarshal(resp, &hook); err != nil {
+		return SystemHook{}, fmt.Errorf("can't unmarshal system hook data: %w", err)
+	}
+
+	return hook, nil
+}
+
+func testSystemHook(ctx context.Context, c *client, hookID int) error {
+	_, err := c.post(ctx, fmt.Sprintf("hooks/%d", hookID), nil)
+	return err
+}
+
+func deleteSystemHook(ctx context.Context, c *client, hookID int) error {
+	return c.delete(ctx, fmt.Sprintf("hooks/%d", hookID))
+}
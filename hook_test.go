@@ -0,0 +1,319 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestClient_ListProjectHooks(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/projects/10/hooks", req.URL.String())
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(`[{
+				"id": 1,
+				"url": "https://bot.test.com/{path}",
+				"project_id": 10,
+				"note_events": true,
+				"enable_ssl_verification": true,
+				"url_variables": [{"key": "path"}],
+				"created_at": "2020-10-01T10:20:30.000Z"
+			}]`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		createdAt := gitlab.Time{Time: time.Date(2020, 10, 1, 10, 20, 30, 0, time.UTC)}
+
+		hooks, err := client.Projects().ListHooks(context.Background(), 10, gitlab.ListOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, []gitlab.Hook{{
+			ID:                    1,
+			Url:                   "https://bot.test.com/{path}",
+			ProjectID:             10,
+			NoteEvents:            true,
+			EnableSslVerification: true,
+			UrlVariables:          []gitlab.HookUrlVariable{{Key: "path"}},
+			CreatedAt:             &createdAt,
+		}}, hooks)
+	})
+
+	t.Run("error on getting hooks", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expErr)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		hooks, err := client.Projects().ListHooks(context.Background(), 10, gitlab.ListOptions{})
+		assert.True(t, errors.Is(err, expErr))
+		assert.Equal(t, []gitlab.Hook(nil), hooks)
+	})
+}
+
+func TestClient_AddGroupHook(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		var (
+			baseUrl = "http://gitlab.test.com/api/v4"
+			enabled = true
+		)
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, baseUrl+"/groups/5/hooks", req.URL.String())
+
+			body, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{
+				"url": "https://bot.test.com/hook",
+				"token": "secret",
+				"merge_requests_events": true,
+				"note_events": true,
+				"enable_ssl_verification": true
+			}`, string(body))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 1, "group_id": 5, "merge_requests_events": true}`))),
+			StatusCode: http.StatusCreated,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		hook, err := client.Groups().AddHook(context.Background(), 5, gitlab.HookOptions{
+			Url:                   "https://bot.test.com/hook",
+			Token:                 "secret",
+			MergeRequestsEvents:   &enabled,
+			NoteEvents:            &enabled,
+			EnableSslVerification: &enabled,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.Hook{ID: 1, GroupID: 5, MergeRequestsEvents: true}, hook)
+	})
+
+	t.Run("error on unmarshal response", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{"))),
+			StatusCode: http.StatusCreated,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		hook, err := client.Groups().AddHook(context.Background(), 5, gitlab.HookOptions{Url: "https://bot.test.com/hook"})
+		assert.Error(t, err)
+		assert.Equal(t, gitlab.Hook{}, hook)
+	})
+}
+
+func TestClient_EditProjectHook(t *testing.T) {
+	var (
+		baseUrl     = "http://gitlab.test.com/api/v4"
+		disabled    = false
+		allBranches = ""
+	)
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, baseUrl+"/projects/10/hooks/1", req.URL.String())
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"url": "https://bot.test.com/hook",
+			"push_events": false,
+			"push_events_branch_filter": ""
+		}`, string(body))
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 1}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	hook, err := client.Projects().EditHook(context.Background(), 10, 1, gitlab.HookOptions{
+		Url:                    "https://bot.test.com/hook",
+		PushEvents:             &disabled,
+		PushEventsBranchFilter: &allBranches,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, hook.ID)
+}
+
+func TestClient_TestProjectHook(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, baseUrl+"/projects/10/hooks/1/test/note_events", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"message": "201 Created"}`))),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	assert.NoError(t, client.Projects().TestHook(context.Background(), 10, 1, gitlab.HookTriggerNote))
+}
+
+func TestClient_SetGroupHookUrlVariable(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, baseUrl+"/groups/5/hooks/1/url_variables/path", req.URL.String())
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"value": "webhook"}`, string(body))
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		StatusCode: http.StatusNoContent,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	assert.NoError(t, client.Groups().SetHookUrlVariable(context.Background(), 5, 1, "path", "webhook"))
+}
+
+func TestClient_DeleteGroupHook(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodDelete, req.Method)
+		assert.Equal(t, baseUrl+"/groups/5/hooks/1", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		StatusCode: http.StatusNoContent,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	assert.NoError(t, client.Groups().DeleteHook(context.Background(), 5, 1))
+}
+
+func TestClient_AddSystemHook(t *testing.T) {
+	var (
+		baseUrl = "http://gitlab.test.com/api/v4"
+		enabled = true
+	)
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, baseUrl+"/hooks", req.URL.String())
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"url": "https://bot.test.com/system", "repository_update_events": true}`, string(body))
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 1, "repository_update_events": true}`))),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	hook, err := client.SystemHooks().Add(context.Background(), gitlab.SystemHookOptions{
+		Url:                    "https://bot.test.com/system",
+		RepositoryUpdateEvents: &enabled,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, gitlab.SystemHook{ID: 1, RepositoryUpdateEvents: true}, hook)
+}
+
+func TestClient_TestSystemHook(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, baseUrl+"/hooks/1", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{}`))),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	assert.NoError(t, client.SystemHooks().Test(context.Background(), 1))
+}
@@ -0,0 +1,358 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// param is a parameter or a result of interface method
+	param struct {
+		name string
+		typ  string
+	}
+
+	method struct {
+		name    string
+		params  []param
+		results []param
+	}
+
+	generator struct {
+		buf      bytes.Buffer
+		mockName string
+		methods  []method
+	}
+)
+
+// generateFile returns formatted source code of mock of the interface declared in the file
+func generateFile(filename string, name string) ([]byte, error) {
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, filename, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse source file: %w", err)
+	}
+
+	iface := findInterface(file, name)
+	if iface == nil {
+		return nil, fmt.Errorf("interface %s not found in %s", name, filename)
+	}
+
+	g := &generator{mockName: "Mock" + name}
+	used := map[string]bool{}
+
+	for _, field := range iface.Methods.List {
+		fn, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			return nil, fmt.Errorf("embedded interfaces of %s are not supported", name)
+		}
+
+		m := method{name: field.Names[0].Name}
+		m.params = fieldParams(fset, fn.Params, "", used)
+		m.results = fieldParams(fset, fn.Results, "_a", used)
+
+		g.methods = append(g.methods, m)
+	}
+
+	sort.Slice(g.methods, func(i, j int) bool { return g.methods[i].name < g.methods[j].name })
+
+	std, external := []string{}, []string{strconv.Quote("github.com/stretchr/testify/mock")}
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+
+		alias := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			alias = spec.Name.Name
+		}
+
+		switch {
+		case !used[alias]:
+		case strings.Contains(strings.Split(path, "/")[0], "."):
+			external = append(external, spec.Path.Value)
+		default:
+			std = append(std, spec.Path.Value)
+		}
+	}
+
+	sort.Strings(std)
+	sort.Strings(external)
+
+	g.header(file.Name.Name, name, std, external)
+	for _, m := range g.methods {
+		g.method(m)
+	}
+
+	g.expecter()
+	for _, m := range g.methods {
+		g.call(m)
+	}
+
+	code, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("can't format generated code: %w", err)
+	}
+
+	return code, nil
+}
+
+func findInterface(file *ast.File, name string) *ast.InterfaceType {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if iface, ok := ts.Type.(*ast.InterfaceType); ok && ts.Name.Name == name {
+				return iface
+			}
+		}
+	}
+
+	return nil
+}
+
+// fieldParams flattens field list, unnamed fields are named by prefix and index,
+// package names of types are collected to used
+func fieldParams(fset *token.FileSet, fields *ast.FieldList, prefix string, used map[string]bool) []param {
+	if fields == nil {
+		return nil
+	}
+
+	var params []param
+	for _, field := range fields.List {
+		ast.Inspect(field.Type, func(node ast.Node) bool {
+			if sel, ok := node.(*ast.SelectorExpr); ok {
+				if ident, ok := sel.X.(*ast.Ident); ok {
+					used[ident.Name] = true
+				}
+			}
+			return true
+		})
+
+		var typ bytes.Buffer
+		_ = printer.Fprint(&typ, fset, field.Type)
+
+		if len(field.Names) == 0 || prefix != "" {
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+
+			for i := 0; i < n; i++ {
+				params = append(params, param{name: prefix + strconv.Itoa(len(params)), typ: typ.String()})
+			}
+			continue
+		}
+
+		for _, ident := range field.Names {
+			params = append(params, param{name: ident.Name, typ: typ.String()})
+		}
+	}
+
+	return params
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) header(pkg string, name string, std []string, external []string) {
+	g.printf("// Code generated by mockgen. DO NOT EDIT.\n\n")
+	g.printf("package %s\n\n", pkg)
+	g.printf("import (\n")
+	if len(std) > 0 {
+		g.printf("%s\n\n", strings.Join(std, "\n"))
+	}
+	g.printf("%s\n)\n\n", strings.Join(external, "\n"))
+	g.printf("var _ %s = (*%s)(nil)\n\n", name, g.mockName)
+	g.printf("// %s is an autogenerated mock type for the %s type\n", g.mockName, name)
+	g.printf("type %s struct {\n\tmock.Mock\n}\n\n", g.mockName)
+}
+
+func (g *generator) method(m method) {
+	names := paramNames(m.params)
+	funcType := fmt.Sprintf("func(%s)", joinTypes(m.params))
+	callArgs := callArgs(m.params)
+
+	g.printf("// %s provides a mock function with given fields: %s\n", m.name, strings.Join(names, ", "))
+	g.printf("func (_m *%s) %s(%s) %s {\n", g.mockName, m.name, joinParams(m.params), resultsSignature(m.results))
+
+	// variadic arguments are passed to mock one by one, so expectations don't depend on slice
+	called := strings.Join(names, ", ")
+	if last, ok := variadic(m.params); ok {
+		g.printf("\t_ca := []interface{}{%s}\n", strings.Join(names[:len(names)-1], ", "))
+		g.printf("\tfor _, _a := range %s {\n\t\t_ca = append(_ca, _a)\n\t}\n\n", last.name)
+		called = "_ca..."
+	}
+
+	if len(m.results) == 0 {
+		g.printf("\t_m.Called(%s)\n}\n\n", called)
+		return
+	}
+
+	g.printf("\tret := _m.Called(%s)\n\n", called)
+
+	results := make([]string, 0, len(m.results))
+	for i, result := range m.results {
+		r := "r" + strconv.Itoa(i)
+		results = append(results, r)
+
+		g.printf("\tvar %s %s\n", r, result.typ)
+		g.printf("\tif rf, ok := ret.Get(%d).(%s %s); ok {\n", i, funcType, result.typ)
+		g.printf("\t\t%s = rf(%s)\n", r, callArgs)
+		g.printf("\t} else {\n")
+
+		switch {
+		case result.typ == "error":
+			g.printf("\t\t%s = ret.Error(%d)\n", r, i)
+		case nilable(result.typ):
+			g.printf("\t\tif ret.Get(%d) != nil {\n\t\t\t%s = ret.Get(%d).(%s)\n\t\t}\n", i, r, i, result.typ)
+		default:
+			g.printf("\t\t%s = ret.Get(%d).(%s)\n", r, i, result.typ)
+		}
+
+		g.printf("\t}\n\n")
+	}
+
+	g.printf("\treturn %s\n}\n\n", strings.Join(results, ", "))
+}
+
+func (g *generator) expecter() {
+	g.printf("// %s_Expecter provides typed helpers to set expectations\n", g.mockName)
+	g.printf("type %s_Expecter struct {\n\tmock *mock.Mock\n}\n\n", g.mockName)
+	g.printf("// EXPECT returns typed helpers to set expectations\n")
+	g.printf("func (_m *%s) EXPECT() *%s_Expecter {\n", g.mockName, g.mockName)
+	g.printf("\treturn &%s_Expecter{mock: &_m.Mock}\n}\n\n", g.mockName)
+}
+
+func (g *generator) call(m method) {
+	callType := fmt.Sprintf("%s_%s_Call", g.mockName, m.name)
+	names := paramNames(m.params)
+
+	last, isVariadic := variadic(m.params)
+
+	args := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		if p.variadic() {
+			args = append(args, p.name+" ...interface{}")
+			continue
+		}
+
+		args = append(args, p.name+" interface{}")
+	}
+
+	g.printf("// %s is an expectation of %s call\n", callType, m.name)
+	g.printf("type %s struct {\n\t*mock.Call\n}\n\n", callType)
+
+	g.printf("// %s sets expectation of %s call, arguments are values or argument matchers\n", m.name, m.name)
+	g.printf("func (_e *%s_Expecter) %s(%s) *%s {\n", g.mockName, m.name, strings.Join(args, ", "), callType)
+	if isVariadic {
+		fixed := strings.Join(names[:len(names)-1], ", ")
+		g.printf("\t_ca := append([]interface{}{%s}, %s...)\n", fixed, last.name)
+		g.printf("\treturn &%s{Call: _e.mock.On(%q, _ca...)}\n}\n\n", callType, m.name)
+	} else {
+		g.printf("\treturn &%s{Call: _e.mock.On(%q", callType, m.name)
+		for _, name := range names {
+			g.printf(", %s", name)
+		}
+		g.printf(")}\n}\n\n")
+	}
+
+	g.printf("// Run sets function called with arguments of %s call\n", m.name)
+	g.printf("func (_c *%s) Run(run func(%s)) *%s {\n", callType, joinParams(m.params), callType)
+	g.printf("\t_c.Call.Run(func(args mock.Arguments) {\n")
+	for i, p := range m.params {
+		if p.variadic() {
+			elem := strings.TrimPrefix(p.typ, "...")
+			g.printf("\t\t%s := make([]%s, 0, len(args)-%d)\n", p.name, elem, i)
+			g.printf("\t\tfor _, _a := range args[%d:] {\n", i)
+			g.printf("\t\t\t_v, _ := _a.(%s)\n\t\t\t%s = append(%s, _v)\n\t\t}\n", elem, p.name, p.name)
+			continue
+		}
+
+		g.printf("\t\t%s, _ := args[%d].(%s)\n", p.name, i, p.typ)
+	}
+	g.printf("\t\trun(%s)\n\t})\n\n\treturn _c\n}\n\n", callArgs(m.params))
+
+	g.printf("// Return sets values returned by %s call\n", m.name)
+	g.printf("func (_c *%s) Return(%s) *%s {\n", callType, joinParams(m.results), callType)
+	g.printf("\t_c.Call.Return(%s)\n\n\treturn _c\n}\n\n", strings.Join(paramNames(m.results), ", "))
+}
+
+func nilable(typ string) bool {
+	return strings.HasPrefix(typ, "*") || strings.HasPrefix(typ, "[]") ||
+		strings.HasPrefix(typ, "map[") || strings.HasPrefix(typ, "func(") ||
+		strings.HasPrefix(typ, "chan ") || strings.HasPrefix(typ, "interface{")
+}
+
+func (p param) variadic() bool {
+	return strings.HasPrefix(p.typ, "...")
+}
+
+// variadic returns the last parameter if it is variadic
+func variadic(params []param) (param, bool) {
+	if len(params) == 0 || !params[len(params)-1].variadic() {
+		return param{}, false
+	}
+
+	return params[len(params)-1], true
+}
+
+// callArgs returns arguments of call by parameter names with expanded variadic one
+func callArgs(params []param) string {
+	names := paramNames(params)
+	if _, ok := variadic(params); ok {
+		names[len(names)-1] += "..."
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func paramNames(params []param) []string {
+	names := make([]string, 0, len(params))
+	for _, p := range params {
+		names = append(names, p.name)
+	}
+
+	return names
+}
+
+func joinParams(params []param) string {
+	res := make([]string, 0, len(params))
+	for _, p := range params {
+		res = append(res, p.name+" "+p.typ)
+	}
+
+	return strings.Join(res, ", ")
+}
+
+func joinTypes(params []param) string {
+	res := make([]string, 0, len(params))
+	for _, p := range params {
+		res = append(res, p.typ)
+	}
+
+	return strings.Join(res, ", ")
+}
+
+func resultsSignature(results []param) string {
+	switch len(results) {
+	case 0:
+		return ""
+	case 1:
+		return results[0].typ
+	}
+
+	return "(" + joinTypes(results) + ")"
+}
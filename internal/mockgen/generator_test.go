@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const directivePrefix = "//go:generate go run ./internal/mockgen "
+
+// directives returns arguments of mockgen generate directives of root package
+func directives(t *testing.T, root string) [][]string {
+	files, err := filepath.Glob(filepath.Join(root, "*.go"))
+	assert.NoError(t, err)
+
+	var res [][]string
+	for _, filename := range files {
+		file, err := os.Open(filename)
+		assert.NoError(t, err)
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			if line := scanner.Text(); strings.HasPrefix(line, directivePrefix) {
+				res = append(res, strings.Fields(strings.TrimPrefix(line, directivePrefix)))
+			}
+		}
+
+		file.Close()
+	}
+
+	return res
+}
+
+func TestGenerateFile(t *testing.T) {
+	t.Run("mocks are up to date", func(t *testing.T) {
+		root := filepath.Join("..", "..")
+
+		dirs := directives(t, root)
+		assert.NotEmpty(t, dirs)
+
+		for _, args := range dirs {
+			opts := map[string]string{}
+			for i := 0; i+1 < len(args); i += 2 {
+				opts[args[i]] = args[i+1]
+			}
+
+			code, err := generateFile(filepath.Join(root, opts["-source"]), opts["-name"])
+			assert.NoError(t, err)
+
+			existing, err := ioutil.ReadFile(filepath.Join(root, opts["-output"]))
+			assert.NoError(t, err)
+
+			assert.Equal(t, string(code), string(existing), "%s is out of date, run go generate", opts["-output"])
+		}
+	})
+
+	t.Run("interface not found", func(t *testing.T) {
+		_, err := generateFile(filepath.Join("..", "..", "client.go"), "Unknown")
+		assert.EqualError(t, err, "interface Unknown not found in ../../client.go")
+	})
+}
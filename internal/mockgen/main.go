@@ -0,0 +1,40 @@
+// Command mockgen generates testify mocks of interfaces declared in a go source file.
+//
+// Mocks are compatible with ones generated by mockery and additionally provide typed expectation helpers
+// returned by EXPECT method and compile-time assertion that mock implements the interface.
+//
+//	go run ./internal/mockgen -source client.go -name Client -output mock_client.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+)
+
+func main() {
+	source := flag.String("source", "", "go source file declaring the interface")
+	name := flag.String("name", "", "name of the interface")
+	output := flag.String("output", "", "output file, stdout if empty")
+	flag.Parse()
+
+	if *source == "" || *name == "" {
+		flag.Usage()
+		log.Fatal("source and name are required")
+	}
+
+	code, err := generateFile(*source, *name)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *output == "" {
+		fmt.Print(string(code))
+		return
+	}
+
+	if err = ioutil.WriteFile(*output, code, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
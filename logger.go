@@ -0,0 +1,155 @@
+// Package gitlab - logger
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	// sensitiveHeaders are always redacted in logs
+	sensitiveHeaders = []string{"Private-Token", "Job-Token", "Authorization"}
+
+	// sensitiveQueryParams are credentials gitlab accepts in query, they are always redacted in logged path
+	sensitiveQueryParams = map[string]bool{"private_token": true, "job_token": true, "access_token": true}
+)
+
+type (
+	// Logger writes structured records with key/value pairs, *slog.Logger satisfies it
+	Logger interface {
+		InfoContext(ctx context.Context, msg string, args ...interface{})
+		ErrorContext(ctx context.Context, msg string, args ...interface{})
+	}
+
+	// LoggerOptions contains parameters of request logging
+	LoggerOptions struct {
+		// RedactHeaders are additional request headers which values are hidden
+		RedactHeaders []string
+		// LogBodies enables logging of JSON request bodies
+		LogBodies bool
+		// RedactFields are names of JSON body fields which values are hidden on any nesting level
+		RedactFields []string
+	}
+
+	requestLogger struct {
+		logger        Logger
+		redactHeaders map[string]bool
+		redactFields  map[string]bool
+		logBodies     bool
+	}
+)
+
+func newRequestLogger(logger Logger, opts LoggerOptions) *requestLogger {
+	rl := &requestLogger{
+		logger:        logger,
+		redactHeaders: map[string]bool{},
+		redactFields:  map[string]bool{},
+		logBodies:     opts.LogBodies,
+	}
+
+	for _, header := range append(sensitiveHeaders, opts.RedactHeaders...) {
+		rl.redactHeaders[http.CanonicalHeaderKey(header)] = true
+	}
+
+	for _, field := range opts.RedactFields {
+		rl.redactFields[strings.ToLower(field)] = true
+	}
+
+	return rl
+}
+
+func (rl *requestLogger) log(ctx context.Context, ex *exchange, err error) {
+	args := []interface{}{
+		"method", ex.method,
+		"path", redactPath(ex.path),
+		"status", ex.status,
+		"duration", time.Since(ex.start),
+		"retries", ex.retries(),
+		"request_id", ex.requestID,
+		"headers", rl.headers(ex.header),
+	}
+
+	if rl.logBodies && len(ex.body) > 0 {
+		args = append(args, "request_body", rl.body(ex.body))
+	}
+
+	if err != nil {
+		rl.logger.ErrorContext(ctx, "gitlab request failed", append(args, "error", err.Error())...)
+		return
+	}
+
+	rl.logger.InfoContext(ctx, "gitlab request", args...)
+}
+
+func (rl *requestLogger) headers(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		if rl.redactHeaders[http.CanonicalHeaderKey(name)] {
+			headers[name] = redacted
+			continue
+		}
+
+		headers[name] = strings.Join(values, ", ")
+	}
+
+	return headers
+}
+
+// redactPath hides values of credential query parameters keeping the rest of path as is
+func redactPath(path string) string {
+	i := strings.Index(path, "?")
+	if i < 0 {
+		return path
+	}
+
+	params := strings.Split(path[i+1:], "&")
+	for j, param := range params {
+		key := strings.SplitN(param, "=", 2)[0]
+		if unescaped, err := url.QueryUnescape(key); err == nil && sensitiveQueryParams[strings.ToLower(unescaped)] {
+			params[j] = key + "=" + redacted
+		}
+	}
+
+	return path[:i+1] + strings.Join(params, "&")
+}
+
+// body returns JSON body with redacted fields, non JSON bodies are not logged
+func (rl *requestLogger) body(body []byte) string {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return "[NON-JSON BODY]"
+	}
+
+	res, err := json.Marshal(rl.redact(data))
+	if err != nil {
+		return "[NON-JSON BODY]"
+	}
+
+	return string(res)
+}
+
+func (rl *requestLogger) redact(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if rl.redactFields[strings.ToLower(key)] {
+				v[key] = redacted
+				continue
+			}
+
+			v[key] = rl.redact(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = rl.redact(value)
+		}
+	}
+
+	return data
+}
@@ -0,0 +1,203 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+type (
+	testLogger struct {
+		records []testLogRecord
+	}
+
+	testLogRecord struct {
+		level string
+		msg   string
+		attrs map[string]interface{}
+	}
+)
+
+func (l *testLogger) InfoContext(_ context.Context, msg string, args ...interface{}) {
+	l.add("info", msg, args)
+}
+
+func (l *testLogger) ErrorContext(_ context.Context, msg string, args ...interface{}) {
+	l.add("error", msg, args)
+}
+
+func (l *testLogger) add(level string, msg string, args []interface{}) {
+	attrs := map[string]interface{}{}
+	for i := 0; i+1 < len(args); i += 2 {
+		attrs[args[i].(string)] = args[i+1]
+	}
+
+	l.records = append(l.records, testLogRecord{level: level, msg: msg, attrs: attrs})
+}
+
+func TestClient_WithLogger(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	t.Run("positive case", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Header:     http.Header{"X-Request-Id": []string{"req-1"}},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 1, "url": "http://hook.test.com"}`))),
+			StatusCode: http.StatusCreated,
+		}, nil).Once()
+
+		logger := new(testLogger)
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithMiddleware(func(next gitlab.RoundTripFunc) gitlab.RoundTripFunc {
+				return func(req *http.Request) (*http.Response, error) {
+					req.Header.Set("Authorization", "Bearer secret")
+					req.Header.Set("X-Secret", "secret")
+					return next(req)
+				}
+			}),
+			gitlab.WithLogger(logger, gitlab.LoggerOptions{
+				RedactHeaders: []string{"x-secret"},
+				LogBodies:     true,
+				RedactFields:  []string{"Token"},
+			}),
+		)
+
+		_, err := client.Projects().AddHook(context.Background(), 10, gitlab.HookOptions{
+			Url:   "http://hook.test.com",
+			Token: "hook_secret",
+		})
+		assert.NoError(t, err)
+
+		if assert.Len(t, logger.records, 1) {
+			record := logger.records[0]
+			assert.Equal(t, "info", record.level)
+			assert.Equal(t, http.MethodPost, record.attrs["method"])
+			assert.Equal(t, "projects/10/hooks", record.attrs["path"])
+			assert.Equal(t, http.StatusCreated, record.attrs["status"])
+			assert.Equal(t, 0, record.attrs["retries"])
+			assert.Equal(t, "req-1", record.attrs["request_id"])
+			assert.Contains(t, record.attrs, "duration")
+
+			headers := record.attrs["headers"].(map[string]string)
+			assert.Equal(t, "[REDACTED]", headers["Private-Token"])
+			assert.Equal(t, "[REDACTED]", headers["Authorization"])
+			assert.Equal(t, "[REDACTED]", headers["X-Secret"])
+			assert.Equal(t, "application/json; charset=utf-8", headers["Content-Type"])
+
+			body := record.attrs["request_body"].(string)
+			assert.Contains(t, body, `"token":"[REDACTED]"`)
+			assert.Contains(t, body, `"url":"http://hook.test.com"`)
+			assert.NotContains(t, body, "hook_secret")
+		}
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusNotFound,
+		}, nil).Once()
+
+		logger := new(testLogger)
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithLogger(logger, gitlab.LoggerOptions{}),
+		)
+
+		_, err := client.GetUserByID(context.Background(), 5)
+		assert.Error(t, err)
+
+		if assert.Len(t, logger.records, 1) {
+			record := logger.records[0]
+			assert.Equal(t, "error", record.level)
+			assert.Equal(t, http.StatusNotFound, record.attrs["status"])
+			assert.Equal(t, err.Error(), record.attrs["error"])
+			assert.NotContains(t, record.attrs, "request_body")
+		}
+	})
+
+	t.Run("retried request", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusBadGateway,
+		}, nil).Once()
+
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 5}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		logger := new(testLogger)
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithMiddleware(func(next gitlab.RoundTripFunc) gitlab.RoundTripFunc {
+				return func(req *http.Request) (*http.Response, error) {
+					resp, err := next(req)
+					if err != nil || resp.StatusCode != http.StatusBadGateway {
+						return resp, err
+					}
+
+					_ = resp.Body.Close()
+					return next(req)
+				}
+			}),
+			gitlab.WithLogger(logger, gitlab.LoggerOptions{}),
+		)
+
+		_, err := client.GetUserByID(context.Background(), 5)
+		assert.NoError(t, err)
+
+		if assert.Len(t, logger.records, 1) {
+			record := logger.records[0]
+			assert.Equal(t, "info", record.level)
+			assert.Equal(t, http.StatusOK, record.attrs["status"])
+			assert.Equal(t, 1, record.attrs["retries"])
+		}
+
+		httpClient.AssertExpectations(t)
+	})
+
+	t.Run("credentials in query", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		logger := new(testLogger)
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithLogger(logger, gitlab.LoggerOptions{}),
+		)
+
+		path := "projects/10/jobs/artifacts/master/download?job=build&job_token=secret&Private_Token=secret&access_token=secret"
+		_, err := client.SendRequest(context.Background(), http.MethodGet, path, nil)
+		assert.NoError(t, err)
+
+		if assert.Len(t, logger.records, 1) {
+			assert.Equal(
+				t,
+				"projects/10/jobs/artifacts/master/download"+
+					"?job=build&job_token=[REDACTED]&Private_Token=[REDACTED]&access_token=[REDACTED]",
+				logger.records[0].attrs["path"],
+			)
+		}
+	})
+}
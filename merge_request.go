@@ -0,0 +1,280 @@
+// Package gitlab - merge request
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type (
+	// MergeRequest entity
+	MergeRequest struct {
+		ID             int          `json:"id"`
+		IID            int          `json:"iid"`
+		ProjectID      int          `json:"project_id"`
+		Title          string       `json:"title"`
+		Description    string       `json:"description"`
+		State          string       `json:"state"`
+		SourceBranch   string       `json:"source_branch"`
+		TargetBranch   string       `json:"target_branch"`
+		Author         NoteAuthor   `json:"author"`
+		Assignees      []NoteAuthor `json:"assignees"`
+		Reviewers      []NoteAuthor `json:"reviewers"`
+		Draft          bool         `json:"draft"`
+		SHA            string       `json:"sha"`
+		MergeCommitSHA string       `json:"merge_commit_sha"`
+		DiffRefs       DiffRefs     `json:"diff_refs"`
+		CreatedAt      *Time        `json:"created_at"`
+		UpdatedAt      *Time        `json:"updated_at"`
+		MergedAt       *Time        `json:"merged_at"`
+		ClosedAt       *Time        `json:"closed_at"`
+		WebUrl         string       `json:"web_url"`
+	}
+
+	// DiffRefs entity contains shas of latest merge request diff version
+	DiffRefs struct {
+		BaseSha  string `json:"base_sha"`
+		HeadSha  string `json:"head_sha"`
+		StartSha string `json:"start_sha"`
+	}
+
+	// ListMergeRequestsOptions contains parameters of merge requests listing
+	ListMergeRequestsOptions struct {
+		ListOptions
+
+		// State is one of "opened", "closed", "locked", "merged" or "all"
+		State string
+		// SourceBranch returns only merge requests with the source branch
+		SourceBranch string
+		// TargetBranch returns only merge requests with the target branch
+		TargetBranch string
+		// Search returns only merge requests with title or description containing the search string
+		Search string
+		// ReviewerID returns only merge requests reviewed by the user (gitlab 13.7 or later)
+		ReviewerID int
+		// OrderBy is one of "created_at" or "updated_at"
+		OrderBy string
+		// Sort is one of "asc" or "desc"
+		Sort string
+	}
+
+	// CreateMergeRequestOptions contains parameters of merge request creation request,
+	// ReviewerIDs require gitlab 13.7 or later
+	CreateMergeRequestOptions struct {
+		SourceBranch       string `json:"source_branch"`
+		TargetBranch       string `json:"target_branch"`
+		Title              string `json:"title"`
+		Description        string `json:"description,omitempty"`
+		AssigneeIDs        []int  `json:"assignee_ids,omitempty"`
+		ReviewerIDs        []int  `json:"reviewer_ids,omitempty"`
+		RemoveSourceBranch bool   `json:"remove_source_branch,omitempty"`
+		Squash             bool   `json:"squash,omitempty"`
+	}
+
+	// UpdateMergeRequestOptions contains parameters of merge request update request, nil fields are not changed,
+	// pointer to empty slice clears assignees or reviewers, ReviewerIDs require gitlab 13.7 or later
+	UpdateMergeRequestOptions struct {
+		Title        *string `json:"title,omitempty"`
+		Description  *string `json:"description,omitempty"`
+		TargetBranch *string `json:"target_branch,omitempty"`
+		AssigneeIDs  *[]int  `json:"assignee_ids,omitempty"`
+		ReviewerIDs  *[]int  `json:"reviewer_ids,omitempty"`
+		// StateEvent is one of "close" or "reopen"
+		StateEvent string `json:"state_event,omitempty"`
+	}
+
+	// MergeRequestChanges entity is a merge request with diffs of changed files
+	MergeRequestChanges struct {
+		MergeRequest
+		Changes []Diff `json:"changes"`
+	}
+
+	// MergeRequestDiffVersion entity
+	MergeRequestDiffVersion struct {
+		ID             int      `json:"id"`
+		HeadCommitSha  string   `json:"head_commit_sha"`
+		BaseCommitSha  string   `json:"base_commit_sha"`
+		StartCommitSha string   `json:"start_commit_sha"`
+		CreatedAt      *Time    `json:"created_at"`
+		MergeRequestID int      `json:"merge_request_id"`
+		State          string   `json:"state"`
+		RealSize       string   `json:"real_size"`
+		Commits        []Commit `json:"commits"`
+		Diffs          []Diff   `json:"diffs"`
+	}
+)
+
+func (opts ListMergeRequestsOptions) values() url.Values {
+	values := opts.ListOptions.values()
+	for key, value := range map[string]string{
+		"state":         opts.State,
+		"source_branch": opts.SourceBranch,
+		"target_branch": opts.TargetBranch,
+		"search":        opts.Search,
+		"order_by":      opts.OrderBy,
+		"sort":          opts.Sort,
+	} {
+		if value != "" {
+			values.Set(key, value)
+		}
+	}
+
+	if opts.ReviewerID > 0 {
+		values.Set("reviewer_id", strconv.Itoa(opts.ReviewerID))
+	}
+
+	return values
+}
+
+func listMergeRequests(
+	ctx context.Context,
+	c *client,
+	projectID int,
+	opts ListMergeRequestsOptions,
+) ([]MergeRequest, error) {
+	if opts.ReviewerID > 0 {
+		if err := c.requireFeature(ctx, FeatureMergeRequestReviewers); err != nil {
+			return nil, err
+		}
+	}
+
+	path := fmt.Sprintf("projects/%d/merge_requests", projectID)
+	resp, err := c.get(ctx, withQuery(path, opts.values()))
+	if err != nil {
+		return nil, err
+	}
+
+	var mrs []MergeRequest
+	if err = json.Unmarshal(resp, &mrs); err != nil {
+		return nil, fmt.Errorf("can't unmarshal merge requests data: %w", err)
+	}
+
+	return mrs, nil
+}
+
+func getMergeRequest(ctx context.Context, c *client, projectID, mrID int) (MergeRequest, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("projects/%d/merge_requests/%d", projectID, mrID))
+	if err != nil {
+		return MergeRequest{}, err
+	}
+
+	var mr MergeRequest
+	if err = json.Unmarshal(resp, &mr); err != nil {
+		return MergeRequest{}, fmt.Errorf("can't unmarshal merge request data: %w", err)
+	}
+
+	return mr, nil
+}
+
+func createMergeRequest(
+	ctx context.Context,
+	c *client,
+	projectID int,
+	opts CreateMergeRequestOptions,
+) (MergeRequest, error) {
+	if len(opts.ReviewerIDs) > 0 {
+		if err := c.requireFeature(ctx, FeatureMergeRequestReviewers); err != nil {
+			return MergeRequest{}, err
+		}
+	}
+
+	return sendMergeRequest(ctx, c.post, fmt.Sprintf("projects/%d/merge_requests", projectID), opts)
+}
+
+func updateMergeRequest(
+	ctx context.Context,
+	c *client,
+	projectID, mrID int,
+	opts UpdateMergeRequestOptions,
+) (MergeRequest, error) {
+	if opts.ReviewerIDs != nil {
+		if err := c.requireFeature(ctx, FeatureMergeRequestReviewers); err != nil {
+			return MergeRequest{}, err
+		}
+	}
+
+	return sendMergeRequest(ctx, c.put, fmt.Sprintf("projects/%d/merge_requests/%d", projectID, mrID), opts)
+}
+
+func sendMergeRequest(
+	ctx context.Context,
+	send func(ctx context.Context, path string, data interface{}) ([]byte, error),
+	path string,
+	opts interface{},
+) (MergeRequest, error) {
+	resp, err := send(ctx, path, opts)
+	if err != nil {
+		return MergeRequest{}, err
+	}
+
+	var mr MergeRequest
+	if err = json.Unmarshal(resp, &mr); err != nil {
+		return MergeRequest{}, fmt.Errorf("can't unmarshal merge request data: %w", err)
+	}
+
+	return mr, nil
+}
+
+func getMergeRequestChanges(ctx context.Context, c *client, projectID, mrID int) (MergeRequestChanges, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("projects/%d/merge_requests/%d/changes", projectID, mrID))
+	if err != nil {
+		return MergeRequestChanges{}, err
+	}
+
+	var changes MergeRequestChanges
+	if err = json.Unmarshal(resp, &changes); err != nil {
+		return MergeRequestChanges{}, fmt.Errorf("can't unmarshal merge request changes data: %w", err)
+	}
+
+	return changes, nil
+}
+
+func listMergeRequestDiffs(ctx context.Context, c *client, projectID, mrID int, opts ListOptions) ([]Diff, error) {
+	path := fmt.Sprintf("projects/%d/merge_requests/%d/diffs", projectID, mrID)
+	resp, err := c.get(ctx, withQuery(path, opts.values()))
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []Diff
+	if err = json.Unmarshal(resp, &diffs); err != nil {
+		return nil, fmt.Errorf("can't unmarshal merge request diffs data: %w", err)
+	}
+
+	return diffs, nil
+}
+
+func listMergeRequestDiffVersions(ctx context.Context, c *client, projectID, mrID int) ([]MergeRequestDiffVersion, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("projects/%d/merge_requests/%d/versions", projectID, mrID))
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []MergeRequestDiffVersion
+	if err = json.Unmarshal(resp, &versions); err != nil {
+		return nil, fmt.Errorf("can't unmarshal merge request diff versions data: %w", err)
+	}
+
+	return versions, nil
+}
+
+func getMergeRequestDiffVersion(
+	ctx context.Context,
+	c *client,
+	projectID, mrID, versionID int,
+) (MergeRequestDiffVersion, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("projects/%d/merge_requests/%d/versions/%d", projectID, mrID, versionID))
+	if err != nil {
+		return MergeRequestDiffVersion{}, err
+	}
+
+	var version MergeRequestDiffVersion
+	if err = json.Unmarshal(resp, &version); err != nil {
+		return MergeRequestDiffVersion{}, fmt.Errorf("can't unmarshal merge request diff version data: %w", err)
+	}
+
+	return version, nil
+}
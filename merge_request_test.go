@@ -0,0 +1,430 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestClient_ListMergeRequests(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/projects/10/merge_requests?page=2&per_page=50&state=opened&target_branch=main",
+				req.URL.String())
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`[{"iid": 20, "title": "test"}]`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		mrs, err := client.MergeRequests().List(context.Background(), 10, gitlab.ListMergeRequestsOptions{
+			ListOptions:  gitlab.ListOptions{Page: 2, PerPage: 50},
+			State:        "opened",
+			TargetBranch: "main",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []gitlab.MergeRequest{{IID: 20, Title: "test"}}, mrs)
+	})
+
+	t.Run("error on unmarshal response", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{"))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		mrs, err := client.MergeRequests().List(context.Background(), 10, gitlab.ListMergeRequestsOptions{})
+		assert.Error(t, err)
+		assert.Nil(t, mrs)
+	})
+
+	t.Run("reviewer filter", func(t *testing.T) {
+		httpClient := newVersionHTTPClient("13.7.0")
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, "reviewer_id=7", req.URL.RawQuery)
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`[{"iid": 20}]`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		client := gitlab.NewClient("test_token", gitlab.WithHttpClient(httpClient))
+
+		mrs, err := client.MergeRequests().List(context.Background(), 10, gitlab.ListMergeRequestsOptions{ReviewerID: 7})
+		assert.NoError(t, err)
+		assert.Equal(t, []gitlab.MergeRequest{{IID: 20}}, mrs)
+		httpClient.AssertExpectations(t)
+	})
+
+	t.Run("reviewer filter unsupported by version", func(t *testing.T) {
+		httpClient := newVersionHTTPClient("13.6.2")
+		client := gitlab.NewClient("test_token", gitlab.WithHttpClient(httpClient))
+
+		mrs, err := client.MergeRequests().List(context.Background(), 10, gitlab.ListMergeRequestsOptions{ReviewerID: 7})
+		assert.True(t, errors.Is(err, gitlab.ErrUnsupported))
+		assert.EqualError(t, err, "merge_request_reviewers requires gitlab 13.7, server version is 13.6.2")
+		assert.Nil(t, mrs)
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+}
+
+func TestClient_CreateMergeRequest(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := newVersionHTTPClient("15.0.0")
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, baseUrl+"/projects/10/merge_requests", req.URL.String())
+
+			body, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{
+				"source_branch": "feature",
+				"target_branch": "main",
+				"title": "Add feature",
+				"reviewer_ids": [1, 2]
+			}`, string(body))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"iid": 20, "title": "Add feature"}`))),
+			StatusCode: http.StatusCreated,
+		}, nil).Once()
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		mr, err := client.MergeRequests().Create(context.Background(), 10, gitlab.CreateMergeRequestOptions{
+			SourceBranch: "feature",
+			TargetBranch: "main",
+			Title:        "Add feature",
+			ReviewerIDs:  []int{1, 2},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.MergeRequest{IID: 20, Title: "Add feature"}, mr)
+		httpClient.AssertExpectations(t)
+	})
+
+	t.Run("reviewers unsupported by version", func(t *testing.T) {
+		httpClient := newVersionHTTPClient("13.6.0")
+		client := gitlab.NewClient("test_token", gitlab.WithHttpClient(httpClient))
+
+		mr, err := client.MergeRequests().Create(context.Background(), 10, gitlab.CreateMergeRequestOptions{
+			SourceBranch: "feature",
+			TargetBranch: "main",
+			Title:        "Add feature",
+			ReviewerIDs:  []int{1},
+		})
+		assert.True(t, errors.Is(err, gitlab.ErrUnsupported))
+		assert.Equal(t, gitlab.MergeRequest{}, mr)
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+}
+
+func TestClient_UpdateMergeRequest(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	t.Run("without reviewers", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodPut, req.Method)
+			assert.Equal(t, baseUrl+"/projects/10/merge_requests/20", req.URL.String())
+
+			body, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"title": "Draft: Add feature", "state_event": "close"}`, string(body))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"iid": 20, "state": "closed"}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		title := "Draft: Add feature"
+		mr, err := client.MergeRequests().Update(context.Background(), 10, 20, gitlab.UpdateMergeRequestOptions{
+			Title:      &title,
+			StateEvent: "close",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.MergeRequest{IID: 20, State: "closed"}, mr)
+		httpClient.AssertExpectations(t)
+	})
+
+	t.Run("clear reviewers", func(t *testing.T) {
+		httpClient := newVersionHTTPClient("13.7.0")
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			body, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"reviewer_ids": []}`, string(body))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"iid": 20}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		client := gitlab.NewClient("test_token", gitlab.WithHttpClient(httpClient))
+
+		_, err := client.MergeRequests().Update(context.Background(), 10, 20, gitlab.UpdateMergeRequestOptions{
+			ReviewerIDs: &[]int{},
+		})
+		assert.NoError(t, err)
+		httpClient.AssertExpectations(t)
+	})
+
+	t.Run("reviewers unsupported by version", func(t *testing.T) {
+		httpClient := newVersionHTTPClient("12.10.0")
+		client := gitlab.NewClient("test_token", gitlab.WithHttpClient(httpClient))
+
+		_, err := client.MergeRequests().Update(context.Background(), 10, 20, gitlab.UpdateMergeRequestOptions{
+			ReviewerIDs: &[]int{1},
+		})
+		assert.True(t, errors.Is(err, gitlab.ErrUnsupported))
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+}
+
+func TestClient_GetMergeRequest(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/projects/10/merge_requests/20", req.URL.String())
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(
+				`{"iid": 20, "title": "test", "author": {"id": 5}, "reviewers": [{"id": 6}]}`,
+			))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		mr, err := client.MergeRequests().Get(context.Background(), 10, 20)
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.MergeRequest{
+			IID:       20,
+			Title:     "test",
+			Author:    gitlab.NoteAuthor{ID: 5},
+			Reviewers: []gitlab.NoteAuthor{{ID: 6}},
+		}, mr)
+	})
+
+	t.Run("error on unmarshal response", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{"))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		mr, err := client.MergeRequests().Get(context.Background(), 10, 20)
+		assert.Error(t, err)
+		assert.Equal(t, gitlab.MergeRequest{}, mr)
+	})
+}
+
+func TestClient_GetMergeRequestChanges(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/changes", req.URL.String())
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(`{
+				"iid": 20,
+				"diff_refs": {"base_sha": "a", "head_sha": "c", "start_sha": "b"},
+				"changes": [{"old_path": "a.go", "new_path": "b.go", "renamed_file": true}]
+			}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		changes, err := client.MergeRequests().GetChanges(context.Background(), 10, 20)
+		assert.NoError(t, err)
+		assert.Equal(t, 20, changes.IID)
+		assert.Equal(t, gitlab.DiffRefs{BaseSha: "a", HeadSha: "c", StartSha: "b"}, changes.DiffRefs)
+		assert.Equal(t, []gitlab.Diff{{OldPath: "a.go", NewPath: "b.go", RenamedFile: true}}, changes.Changes)
+	})
+
+	t.Run("error on getting changes", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expErr)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		changes, err := client.MergeRequests().GetChanges(context.Background(), 10, 20)
+		assert.True(t, errors.Is(err, expErr))
+		assert.Equal(t, gitlab.MergeRequestChanges{}, changes)
+	})
+}
+
+func TestClient_ListMergeRequestDiffs(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/diffs?page=2&per_page=20", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`[{"new_path": "a.go", "new_file": true}]`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	diffs, err := client.MergeRequests().ListDiffs(context.Background(), 10, 20, gitlab.ListOptions{Page: 2, PerPage: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, []gitlab.Diff{{NewPath: "a.go", NewFile: true}}, diffs)
+}
+
+func TestClient_ListMergeRequestDiffVersions(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/versions", req.URL.String())
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(`[
+				{"id": 2, "head_commit_sha": "c2", "base_commit_sha": "a", "start_commit_sha": "b"},
+				{"id": 1, "head_commit_sha": "c1", "base_commit_sha": "a", "start_commit_sha": "b"}
+			]`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		versions, err := client.MergeRequests().ListDiffVersions(context.Background(), 10, 20)
+		assert.NoError(t, err)
+		assert.Equal(t, []gitlab.MergeRequestDiffVersion{
+			{ID: 2, HeadCommitSha: "c2", BaseCommitSha: "a", StartCommitSha: "b"},
+			{ID: 1, HeadCommitSha: "c1", BaseCommitSha: "a", StartCommitSha: "b"},
+		}, versions)
+	})
+
+	t.Run("error on unmarshal response", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{"))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		versions, err := client.MergeRequests().ListDiffVersions(context.Background(), 10, 20)
+		assert.Error(t, err)
+		assert.Equal(t, []gitlab.MergeRequestDiffVersion(nil), versions)
+	})
+}
+
+func TestClient_GetMergeRequestDiffVersion(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/versions/2", req.URL.String())
+	}).Return(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewReader([]byte(
+			`{"id": 2, "commits": [{"id": "c2"}], "diffs": [{"new_path": "a.go", "diff": "@@ -1 +1 @@\n-a\n+b\n"}]}`,
+		))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	version, err := client.MergeRequests().GetDiffVersion(context.Background(), 10, 20, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, gitlab.MergeRequestDiffVersion{
+		ID:      2,
+		Commits: []gitlab.Commit{{ID: "c2"}},
+		Diffs:   []gitlab.Diff{{NewPath: "a.go", Diff: "@@ -1 +1 @@\n-a\n+b\n"}},
+	}, version)
+}
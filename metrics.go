@@ -0,0 +1,106 @@
+// Package gitlab - metrics
+package gitlab
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const rateLimitRemainingHeader = "RateLimit-Remaining"
+
+type (
+	// MetricsCollector receives metrics of every request, implementations must be safe for concurrent use
+	MetricsCollector interface {
+		ObserveRequest(ctx context.Context, metrics RequestMetrics)
+	}
+
+	// RequestMetrics contains measurements of a single request
+	RequestMetrics struct {
+		Method string
+		// Endpoint is a path template without ids and query, e.g. projects/:id/merge_requests/:iid/discussions/:id
+		Endpoint string
+		// Status is a status code of the last response, zero if response was served from cache or not received
+		Status        int
+		Duration      time.Duration
+		RequestBytes  int
+		ResponseBytes int
+		// RateLimitRemaining is a number of requests left in rate limit window, -1 if gitlab didn't report it
+		RateLimitRemaining int
+		Err                error
+	}
+)
+
+// endpointParams maps collections to placeholders of the following path segment
+var endpointParams = map[string]string{
+	"projects":           ":id",
+	"groups":             ":id",
+	"users":              ":id",
+	"merge_requests":     ":iid",
+	"issues":             ":iid",
+	"snippets":           ":id",
+	"discussions":        ":id",
+	"notes":              ":id",
+	"hooks":              ":id",
+	"url_variables":      ":key",
+	"branches":           ":branch",
+	"tags":               ":tag_name",
+	"protected_branches": ":name",
+	"protected_tags":     ":name",
+	"approval_rules":     ":id",
+	"versions":           ":id",
+	"award_emoji":        ":id",
+	"commits":            ":sha",
+}
+
+// Endpoint returns path template of request path with ids replaced by placeholders, numeric and url encoded
+// segments of collections unknown to endpointParams are replaced by :id
+func Endpoint(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 1; i < len(segments); i++ {
+		if param, has := endpointParams[segments[i-1]]; has {
+			segments[i] = param
+		} else if isIDSegment(segments[i]) {
+			segments[i] = ":id"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// isIDSegment reports whether path segment is a numeric id or url encoded one (path of project, file etc.)
+func isIDSegment(segment string) bool {
+	if strings.Contains(segment, "%") {
+		return true
+	}
+
+	_, err := strconv.ParseUint(segment, 10, 64)
+	return err == nil
+}
+
+func (ex *exchange) metrics(responseBytes int, err error) RequestMetrics {
+	return RequestMetrics{
+		Method:             ex.method,
+		Endpoint:           Endpoint(ex.path),
+		Status:             ex.status,
+		Duration:           time.Since(ex.start),
+		RequestBytes:       len(ex.body),
+		ResponseBytes:      responseBytes,
+		RateLimitRemaining: ex.rateLimitRemaining,
+		Err:                err,
+	}
+}
+
+func parseRateLimitRemaining(value string) int {
+	remaining, err := strconv.Atoi(value)
+	if err != nil {
+		return -1
+	}
+
+	return remaining
+}
@@ -0,0 +1,239 @@
+// Package gitlab - prometheus metrics
+package gitlab
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	defaultMetricsNamespace = "gitlab_client"
+	prometheusContentType   = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// DefaultDurationBuckets are upper bounds of request duration histogram in seconds
+var DefaultDurationBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+type (
+	// PrometheusCollector aggregates request metrics and exposes them in prometheus text format,
+	// it can be registered as a scrape handler
+	PrometheusCollector struct {
+		mu        sync.Mutex
+		namespace string
+		buckets   []float64
+		requests  map[requestLabels]float64
+		durations map[endpointLabels]*histogram
+		sent      map[endpointLabels]float64
+		received  map[endpointLabels]float64
+		rateLimit float64
+		hasLimit  bool
+	}
+
+	endpointLabels struct {
+		method   string
+		endpoint string
+	}
+
+	requestLabels struct {
+		endpointLabels
+		status string
+	}
+
+	histogram struct {
+		counts []float64
+		sum    float64
+		count  float64
+	}
+)
+
+// NewPrometheusCollector returns collector with metrics prefixed by namespace ("gitlab_client" if empty)
+// and duration buckets (DefaultDurationBuckets if empty)
+func NewPrometheusCollector(namespace string, buckets ...float64) *PrometheusCollector {
+	if namespace == "" {
+		namespace = defaultMetricsNamespace
+	}
+
+	if len(buckets) == 0 {
+		buckets = DefaultDurationBuckets
+	}
+
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+
+	return &PrometheusCollector{
+		namespace: namespace,
+		buckets:   sorted,
+		requests:  map[requestLabels]float64{},
+		durations: map[endpointLabels]*histogram{},
+		sent:      map[endpointLabels]float64{},
+		received:  map[endpointLabels]float64{},
+	}
+}
+
+// ObserveRequest implements MetricsCollector
+func (p *PrometheusCollector) ObserveRequest(_ context.Context, metrics RequestMetrics) {
+	labels := endpointLabels{method: metrics.Method, endpoint: metrics.Endpoint}
+
+	status := strconv.Itoa(metrics.Status)
+	if metrics.Status == 0 {
+		status = "cached"
+		if metrics.Err != nil {
+			status = "error"
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests[requestLabels{endpointLabels: labels, status: status}]++
+	p.sent[labels] += float64(metrics.RequestBytes)
+	p.received[labels] += float64(metrics.ResponseBytes)
+
+	h, has := p.durations[labels]
+	if !has {
+		h = &histogram{counts: make([]float64, len(p.buckets))}
+		p.durations[labels] = h
+	}
+
+	seconds := metrics.Duration.Seconds()
+	for i, bound := range p.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += seconds
+	h.count++
+
+	if metrics.RateLimitRemaining >= 0 {
+		p.rateLimit = float64(metrics.RateLimitRemaining)
+		p.hasLimit = true
+	}
+}
+
+// ServeHTTP writes metrics in prometheus text exposition format
+func (p *PrometheusCollector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", prometheusContentType)
+
+	buf := bufio.NewWriter(w)
+	p.write(buf)
+	buf.Flush()
+}
+
+func (p *PrometheusCollector) write(w *bufio.Writer) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	name := p.namespace + "_requests_total"
+	writeHeader(w, name, "counter", "Number of gitlab api requests.")
+	requests := make([]requestLabels, 0, len(p.requests))
+	for labels := range p.requests {
+		requests = append(requests, labels)
+	}
+	sort.Slice(requests, func(i, j int) bool {
+		if requests[i].endpointLabels != requests[j].endpointLabels {
+			return requests[i].endpointLabels.less(requests[j].endpointLabels)
+		}
+		return requests[i].status < requests[j].status
+	})
+	for _, labels := range requests {
+		writeSample(w, name, labels.pairs("status", labels.status), p.requests[labels])
+	}
+
+	name = p.namespace + "_request_duration_seconds"
+	writeHeader(w, name, "histogram", "Duration of gitlab api requests in seconds.")
+	for _, labels := range sortedEndpoints(p.durations) {
+		h := p.durations[labels]
+		for i, bound := range p.buckets {
+			writeSample(w, name+"_bucket", labels.pairs("le", formatFloat(bound)), h.counts[i])
+		}
+		writeSample(w, name+"_bucket", labels.pairs("le", "+Inf"), h.count)
+		writeSample(w, name+"_sum", labels.pairs(), h.sum)
+		writeSample(w, name+"_count", labels.pairs(), h.count)
+	}
+
+	name = p.namespace + "_request_bytes_total"
+	writeHeader(w, name, "counter", "Size of gitlab api request bodies in bytes.")
+	for _, labels := range sortedEndpoints(p.sent) {
+		writeSample(w, name, labels.pairs(), p.sent[labels])
+	}
+
+	name = p.namespace + "_response_bytes_total"
+	writeHeader(w, name, "counter", "Size of gitlab api response bodies in bytes.")
+	for _, labels := range sortedEndpoints(p.received) {
+		writeSample(w, name, labels.pairs(), p.received[labels])
+	}
+
+	if p.hasLimit {
+		name = p.namespace + "_rate_limit_remaining"
+		writeHeader(w, name, "gauge", "Number of requests left in gitlab rate limit window.")
+		writeSample(w, name, "", p.rateLimit)
+	}
+}
+
+func (l endpointLabels) less(other endpointLabels) bool {
+	if l.endpoint != other.endpoint {
+		return l.endpoint < other.endpoint
+	}
+
+	return l.method < other.method
+}
+
+// pairs formats labels with additional name/value pairs
+func (l endpointLabels) pairs(extra ...string) string {
+	pairs := []string{"method", l.method, "endpoint", l.endpoint}
+	pairs = append(pairs, extra...)
+
+	res := make([]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		res = append(res, pairs[i]+`="`+escapeLabelValue(pairs[i+1])+`"`)
+	}
+
+	return strings.Join(res, ",")
+}
+
+func sortedEndpoints(values interface{}) []endpointLabels {
+	var res []endpointLabels
+	switch v := values.(type) {
+	case map[endpointLabels]float64:
+		for labels := range v {
+			res = append(res, labels)
+		}
+	case map[endpointLabels]*histogram:
+		for labels := range v {
+			res = append(res, labels)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].less(res[j]) })
+
+	return res
+}
+
+func writeHeader(w *bufio.Writer, name string, kind string, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func writeSample(w *bufio.Writer, name string, labels string, value float64) {
+	if labels != "" {
+		fmt.Fprintf(w, "%s{%s} %s\n", name, labels, formatFloat(value))
+		return
+	}
+
+	fmt.Fprintf(w, "%s %s\n", name, formatFloat(value))
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
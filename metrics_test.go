@@ -0,0 +1,189 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+type testMetricsCollector struct {
+	metrics []gitlab.RequestMetrics
+}
+
+func (c *testMetricsCollector) ObserveRequest(_ context.Context, metrics gitlab.RequestMetrics) {
+	c.metrics = append(c.metrics, metrics)
+}
+
+func TestEndpoint(t *testing.T) {
+	for path, endpoint := range map[string]string{
+		"projects/10/merge_requests/20/discussions/abc": "projects/:id/merge_requests/:iid/discussions/:id",
+		"/users/5":      "users/:id",
+		"users?ids=1,2": "users",
+		"projects/group%2Fproject/repository/branches/dev":  "projects/:id/repository/branches/:branch",
+		"projects/10/merge_requests/20/approve":             "projects/:id/merge_requests/:iid/approve",
+		"projects/10/hooks/3/url_variables/secret":          "projects/:id/hooks/:id/url_variables/:key",
+		"projects/10/repository/tree?ref=main":              "projects/:id/repository/tree",
+		"projects/10/pipelines/30/jobs":                     "projects/:id/pipelines/:id/jobs",
+		"projects/10/repository/files/docs%2FREADME.md/raw": "projects/:id/repository/files/:id/raw",
+		"runners/7":                             "runners/:id",
+		"projects/10/repository/archive.tar.gz": "projects/:id/repository/archive.tar.gz",
+	} {
+		assert.Equal(t, endpoint, gitlab.Endpoint(path), path)
+	}
+}
+
+func TestClient_WithMetrics(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	t.Run("positive case", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Header:     http.Header{"Ratelimit-Remaining": []string{"599"}},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": "abc"}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		collector := new(testMetricsCollector)
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithMetrics(collector),
+		)
+
+		_, err := client.GetDiscussion(context.Background(), 10, 20, "abc")
+		assert.NoError(t, err)
+
+		if assert.Len(t, collector.metrics, 1) {
+			metrics := collector.metrics[0]
+			assert.Equal(t, http.MethodGet, metrics.Method)
+			assert.Equal(t, "projects/:id/merge_requests/:iid/discussions/:id", metrics.Endpoint)
+			assert.Equal(t, http.StatusOK, metrics.Status)
+			assert.Equal(t, 0, metrics.RequestBytes)
+			assert.Equal(t, 13, metrics.ResponseBytes)
+			assert.Equal(t, 599, metrics.RateLimitRemaining)
+			assert.NoError(t, metrics.Err)
+		}
+	})
+
+	t.Run("streamed archive", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("archive content"))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		collector := new(testMetricsCollector)
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithMetrics(collector),
+		)
+
+		assert.NoError(t, client.Repository().GetArchive(context.Background(), 10, ioutil.Discard, gitlab.ArchiveOptions{}))
+
+		if assert.Len(t, collector.metrics, 1) {
+			metrics := collector.metrics[0]
+			assert.Equal(t, "projects/:id/repository/archive.tar.gz", metrics.Endpoint)
+			assert.Equal(t, http.StatusOK, metrics.Status)
+			assert.Equal(t, 15, metrics.ResponseBytes)
+		}
+	})
+
+	t.Run("transport error", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, errors.New("test error")).Once()
+
+		collector := new(testMetricsCollector)
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithMetrics(collector),
+		)
+
+		_, err := client.GetUserByID(context.Background(), 5)
+		assert.Error(t, err)
+
+		if assert.Len(t, collector.metrics, 1) {
+			metrics := collector.metrics[0]
+			assert.Equal(t, 0, metrics.Status)
+			assert.Equal(t, -1, metrics.RateLimitRemaining)
+			assert.Error(t, metrics.Err)
+		}
+	})
+}
+
+func TestPrometheusCollector(t *testing.T) {
+	collector := gitlab.NewPrometheusCollector("", 0.1, 1)
+
+	collector.ObserveRequest(context.Background(), gitlab.RequestMetrics{
+		Method:             http.MethodGet,
+		Endpoint:           "users/:id",
+		Status:             http.StatusOK,
+		Duration:           50 * time.Millisecond,
+		ResponseBytes:      100,
+		RateLimitRemaining: 10,
+	})
+	collector.ObserveRequest(context.Background(), gitlab.RequestMetrics{
+		Method:             http.MethodGet,
+		Endpoint:           "users/:id",
+		Status:             http.StatusNotFound,
+		Duration:           500 * time.Millisecond,
+		ResponseBytes:      20,
+		RateLimitRemaining: -1,
+	})
+	collector.ObserveRequest(context.Background(), gitlab.RequestMetrics{
+		Method:             http.MethodPost,
+		Endpoint:           "projects/:id/hooks",
+		Duration:           2 * time.Second,
+		RequestBytes:       30,
+		RateLimitRemaining: -1,
+		Err:                errors.New("test error"),
+	})
+
+	rec := httptest.NewRecorder()
+	collector.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `# HELP gitlab_client_requests_total Number of gitlab api requests.
+# TYPE gitlab_client_requests_total counter
+gitlab_client_requests_total{method="POST",endpoint="projects/:id/hooks",status="error"} 1
+gitlab_client_requests_total{method="GET",endpoint="users/:id",status="200"} 1
+gitlab_client_requests_total{method="GET",endpoint="users/:id",status="404"} 1
+# HELP gitlab_client_request_duration_seconds Duration of gitlab api requests in seconds.
+# TYPE gitlab_client_request_duration_seconds histogram
+gitlab_client_request_duration_seconds_bucket{method="POST",endpoint="projects/:id/hooks",le="0.1"} 0
+gitlab_client_request_duration_seconds_bucket{method="POST",endpoint="projects/:id/hooks",le="1"} 0
+gitlab_client_request_duration_seconds_bucket{method="POST",endpoint="projects/:id/hooks",le="+Inf"} 1
+gitlab_client_request_duration_seconds_sum{method="POST",endpoint="projects/:id/hooks"} 2
+gitlab_client_request_duration_seconds_count{method="POST",endpoint="projects/:id/hooks"} 1
+gitlab_client_request_duration_seconds_bucket{method="GET",endpoint="users/:id",le="0.1"} 1
+gitlab_client_request_duration_seconds_bucket{method="GET",endpoint="users/:id",le="1"} 2
+gitlab_client_request_duration_seconds_bucket{method="GET",endpoint="users/:id",le="+Inf"} 2
+gitlab_client_request_duration_seconds_sum{method="GET",endpoint="users/:id"} 0.55
+gitlab_client_request_duration_seconds_count{method="GET",endpoint="users/:id"} 2
+# HELP gitlab_client_request_bytes_total Size of gitlab api request bodies in bytes.
+# TYPE gitlab_client_request_bytes_total counter
+gitlab_client_request_bytes_total{method="POST",endpoint="projects/:id/hooks"} 30
+gitlab_client_request_bytes_total{method="GET",endpoint="users/:id"} 0
+# HELP gitlab_client_response_bytes_total Size of gitlab api response bodies in bytes.
+# TYPE gitlab_client_response_bytes_total counter
+gitlab_client_response_bytes_total{method="POST",endpoint="projects/:id/hooks"} 0
+gitlab_client_response_bytes_total{method="GET",endpoint="users/:id"} 120
+# HELP gitlab_client_rate_limit_remaining Number of requests left in gitlab rate limit window.
+# TYPE gitlab_client_rate_limit_remaining gauge
+gitlab_client_rate_limit_remaining 10
+`, rec.Body.String())
+}
@@ -0,0 +1,27 @@
+// Package gitlab - middleware
+package gitlab
+
+import "net/http"
+
+type (
+	// RoundTripFunc sends http request and returns http response, it satisfies HTTPClient interface
+	RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+	// Middleware intercepts round trip between building of the http request and sending it by http client.
+	// It can mutate the request, inspect the response or short-circuit by returning a response without calling next
+	Middleware func(next RoundTripFunc) RoundTripFunc
+)
+
+// Do implements HTTPClient
+func (f RoundTripFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// chainMiddlewares wraps round trip, the first middleware is the outermost one
+func chainMiddlewares(rt RoundTripFunc, middlewares []Middleware) RoundTripFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		rt = middlewares[i](rt)
+	}
+
+	return rt
+}
@@ -0,0 +1,103 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestClient_WithMiddleware(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	t.Run("positive case", func(t *testing.T) {
+		var calls []string
+
+		record := func(name string) gitlab.Middleware {
+			return func(next gitlab.RoundTripFunc) gitlab.RoundTripFunc {
+				return func(req *http.Request) (*http.Response, error) {
+					calls = append(calls, name+" before")
+					req.Header.Add("X-Middleware", name)
+
+					resp, err := next(req)
+
+					calls = append(calls, name+" after")
+					return resp, err
+				}
+			}
+		}
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, []string{"first", "second"}, req.Header.Values("X-Middleware"))
+			calls = append(calls, "http client")
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 5}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithMiddleware(record("first")),
+			gitlab.WithMiddleware(record("second")),
+		)
+
+		user, err := client.GetUserByID(context.Background(), 5)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, user.ID)
+		assert.Equal(t, []string{"first before", "second before", "http client", "second after", "first after"}, calls)
+	})
+
+	t.Run("short circuit", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithMiddleware(func(next gitlab.RoundTripFunc) gitlab.RoundTripFunc {
+				return func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 7}`))),
+						StatusCode: http.StatusOK,
+					}, nil
+				}
+			}),
+		)
+
+		user, err := client.GetUserByID(context.Background(), 7)
+		assert.NoError(t, err)
+		assert.Equal(t, 7, user.ID)
+		httpClient.AssertNotCalled(t, "Do", mock.Anything)
+	})
+
+	t.Run("middleware error", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(new(gitlab.MockHTTPClient)),
+			gitlab.WithMiddleware(func(next gitlab.RoundTripFunc) gitlab.RoundTripFunc {
+				return func(req *http.Request) (*http.Response, error) {
+					return nil, expErr
+				}
+			}),
+		)
+
+		_, err := client.GetUserByID(context.Background(), 7)
+		assert.True(t, errors.Is(err, expErr))
+	})
+}
@@ -0,0 +1,204 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ AwardEmojis = (*MockAwardEmojis)(nil)
+
+// MockAwardEmojis is an autogenerated mock type for the AwardEmojis type
+type MockAwardEmojis struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: ctx, awardable, name, reqOpts
+func (_m *MockAwardEmojis) Add(ctx context.Context, awardable Awardable, name string, reqOpts ...RequestOption) (AwardEmoji, error) {
+	_ca := []interface{}{ctx, awardable, name}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 AwardEmoji
+	if rf, ok := ret.Get(0).(func(context.Context, Awardable, string, ...RequestOption) AwardEmoji); ok {
+		r0 = rf(ctx, awardable, name, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(AwardEmoji)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, Awardable, string, ...RequestOption) error); ok {
+		r1 = rf(ctx, awardable, name, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, awardable, opts, reqOpts
+func (_m *MockAwardEmojis) List(ctx context.Context, awardable Awardable, opts ListOptions, reqOpts ...RequestOption) ([]AwardEmoji, error) {
+	_ca := []interface{}{ctx, awardable, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []AwardEmoji
+	if rf, ok := ret.Get(0).(func(context.Context, Awardable, ListOptions, ...RequestOption) []AwardEmoji); ok {
+		r0 = rf(ctx, awardable, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]AwardEmoji)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, Awardable, ListOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, awardable, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Remove provides a mock function with given fields: ctx, awardable, awardID, reqOpts
+func (_m *MockAwardEmojis) Remove(ctx context.Context, awardable Awardable, awardID int, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, awardable, awardID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, Awardable, int, ...RequestOption) error); ok {
+		r0 = rf(ctx, awardable, awardID, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAwardEmojis_Expecter provides typed helpers to set expectations
+type MockAwardEmojis_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockAwardEmojis) EXPECT() *MockAwardEmojis_Expecter {
+	return &MockAwardEmojis_Expecter{mock: &_m.Mock}
+}
+
+// MockAwardEmojis_Add_Call is an expectation of Add call
+type MockAwardEmojis_Add_Call struct {
+	*mock.Call
+}
+
+// Add sets expectation of Add call, arguments are values or argument matchers
+func (_e *MockAwardEmojis_Expecter) Add(ctx interface{}, awardable interface{}, name interface{}, reqOpts ...interface{}) *MockAwardEmojis_Add_Call {
+	_ca := append([]interface{}{ctx, awardable, name}, reqOpts...)
+	return &MockAwardEmojis_Add_Call{Call: _e.mock.On("Add", _ca...)}
+}
+
+// Run sets function called with arguments of Add call
+func (_c *MockAwardEmojis_Add_Call) Run(run func(ctx context.Context, awardable Awardable, name string, reqOpts ...RequestOption)) *MockAwardEmojis_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		awardable, _ := args[1].(Awardable)
+		name, _ := args[2].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, awardable, name, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Add call
+func (_c *MockAwardEmojis_Add_Call) Return(_a0 AwardEmoji, _a1 error) *MockAwardEmojis_Add_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockAwardEmojis_List_Call is an expectation of List call
+type MockAwardEmojis_List_Call struct {
+	*mock.Call
+}
+
+// List sets expectation of List call, arguments are values or argument matchers
+func (_e *MockAwardEmojis_Expecter) List(ctx interface{}, awardable interface{}, opts interface{}, reqOpts ...interface{}) *MockAwardEmojis_List_Call {
+	_ca := append([]interface{}{ctx, awardable, opts}, reqOpts...)
+	return &MockAwardEmojis_List_Call{Call: _e.mock.On("List", _ca...)}
+}
+
+// Run sets function called with arguments of List call
+func (_c *MockAwardEmojis_List_Call) Run(run func(ctx context.Context, awardable Awardable, opts ListOptions, reqOpts ...RequestOption)) *MockAwardEmojis_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		awardable, _ := args[1].(Awardable)
+		opts, _ := args[2].(ListOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, awardable, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by List call
+func (_c *MockAwardEmojis_List_Call) Return(_a0 []AwardEmoji, _a1 error) *MockAwardEmojis_List_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockAwardEmojis_Remove_Call is an expectation of Remove call
+type MockAwardEmojis_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove sets expectation of Remove call, arguments are values or argument matchers
+func (_e *MockAwardEmojis_Expecter) Remove(ctx interface{}, awardable interface{}, awardID interface{}, reqOpts ...interface{}) *MockAwardEmojis_Remove_Call {
+	_ca := append([]interface{}{ctx, awardable, awardID}, reqOpts...)
+	return &MockAwardEmojis_Remove_Call{Call: _e.mock.On("Remove", _ca...)}
+}
+
+// Run sets function called with arguments of Remove call
+func (_c *MockAwardEmojis_Remove_Call) Run(run func(ctx context.Context, awardable Awardable, awardID int, reqOpts ...RequestOption)) *MockAwardEmojis_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		awardable, _ := args[1].(Awardable)
+		awardID, _ := args[2].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, awardable, awardID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Remove call
+func (_c *MockAwardEmojis_Remove_Call) Return(_a0 error) *MockAwardEmojis_Remove_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
@@ -0,0 +1,319 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Branches = (*MockBranches)(nil)
+
+// MockBranches is an autogenerated mock type for the Branches type
+type MockBranches struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, projectID, branch, ref, reqOpts
+func (_m *MockBranches) Create(ctx context.Context, projectID int, branch string, ref string, reqOpts ...RequestOption) (Branch, error) {
+	_ca := []interface{}{ctx, projectID, branch, ref}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Branch
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string, ...RequestOption) Branch); ok {
+		r0 = rf(ctx, projectID, branch, ref, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Branch)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, string, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, branch, ref, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, projectID, branch, reqOpts
+func (_m *MockBranches) Delete(ctx context.Context, projectID int, branch string, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, projectID, branch}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, ...RequestOption) error); ok {
+		r0 = rf(ctx, projectID, branch, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteMerged provides a mock function with given fields: ctx, projectID, reqOpts
+func (_m *MockBranches) DeleteMerged(ctx context.Context, projectID int, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, projectID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, ...RequestOption) error); ok {
+		r0 = rf(ctx, projectID, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, projectID, branch, reqOpts
+func (_m *MockBranches) Get(ctx context.Context, projectID int, branch string, reqOpts ...RequestOption) (Branch, error) {
+	_ca := []interface{}{ctx, projectID, branch}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Branch
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, ...RequestOption) Branch); ok {
+		r0 = rf(ctx, projectID, branch, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Branch)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, branch, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockBranches) List(ctx context.Context, projectID int, opts ListBranchesOptions, reqOpts ...RequestOption) ([]Branch, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []Branch
+	if rf, ok := ret.Get(0).(func(context.Context, int, ListBranchesOptions, ...RequestOption) []Branch); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Branch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ListBranchesOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBranches_Expecter provides typed helpers to set expectations
+type MockBranches_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockBranches) EXPECT() *MockBranches_Expecter {
+	return &MockBranches_Expecter{mock: &_m.Mock}
+}
+
+// MockBranches_Create_Call is an expectation of Create call
+type MockBranches_Create_Call struct {
+	*mock.Call
+}
+
+// Create sets expectation of Create call, arguments are values or argument matchers
+func (_e *MockBranches_Expecter) Create(ctx interface{}, projectID interface{}, branch interface{}, ref interface{}, reqOpts ...interface{}) *MockBranches_Create_Call {
+	_ca := append([]interface{}{ctx, projectID, branch, ref}, reqOpts...)
+	return &MockBranches_Create_Call{Call: _e.mock.On("Create", _ca...)}
+}
+
+// Run sets function called with arguments of Create call
+func (_c *MockBranches_Create_Call) Run(run func(ctx context.Context, projectID int, branch string, ref string, reqOpts ...RequestOption)) *MockBranches_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		branch, _ := args[2].(string)
+		ref, _ := args[3].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, branch, ref, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Create call
+func (_c *MockBranches_Create_Call) Return(_a0 Branch, _a1 error) *MockBranches_Create_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockBranches_Delete_Call is an expectation of Delete call
+type MockBranches_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete sets expectation of Delete call, arguments are values or argument matchers
+func (_e *MockBranches_Expecter) Delete(ctx interface{}, projectID interface{}, branch interface{}, reqOpts ...interface{}) *MockBranches_Delete_Call {
+	_ca := append([]interface{}{ctx, projectID, branch}, reqOpts...)
+	return &MockBranches_Delete_Call{Call: _e.mock.On("Delete", _ca...)}
+}
+
+// Run sets function called with arguments of Delete call
+func (_c *MockBranches_Delete_Call) Run(run func(ctx context.Context, projectID int, branch string, reqOpts ...RequestOption)) *MockBranches_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		branch, _ := args[2].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, branch, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Delete call
+func (_c *MockBranches_Delete_Call) Return(_a0 error) *MockBranches_Delete_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockBranches_DeleteMerged_Call is an expectation of DeleteMerged call
+type MockBranches_DeleteMerged_Call struct {
+	*mock.Call
+}
+
+// DeleteMerged sets expectation of DeleteMerged call, arguments are values or argument matchers
+func (_e *MockBranches_Expecter) DeleteMerged(ctx interface{}, projectID interface{}, reqOpts ...interface{}) *MockBranches_DeleteMerged_Call {
+	_ca := append([]interface{}{ctx, projectID}, reqOpts...)
+	return &MockBranches_DeleteMerged_Call{Call: _e.mock.On("DeleteMerged", _ca...)}
+}
+
+// Run sets function called with arguments of DeleteMerged call
+func (_c *MockBranches_DeleteMerged_Call) Run(run func(ctx context.Context, projectID int, reqOpts ...RequestOption)) *MockBranches_DeleteMerged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-2)
+		for _, _a := range args[2:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by DeleteMerged call
+func (_c *MockBranches_DeleteMerged_Call) Return(_a0 error) *MockBranches_DeleteMerged_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockBranches_Get_Call is an expectation of Get call
+type MockBranches_Get_Call struct {
+	*mock.Call
+}
+
+// Get sets expectation of Get call, arguments are values or argument matchers
+func (_e *MockBranches_Expecter) Get(ctx interface{}, projectID interface{}, branch interface{}, reqOpts ...interface{}) *MockBranches_Get_Call {
+	_ca := append([]interface{}{ctx, projectID, branch}, reqOpts...)
+	return &MockBranches_Get_Call{Call: _e.mock.On("Get", _ca...)}
+}
+
+// Run sets function called with arguments of Get call
+func (_c *MockBranches_Get_Call) Run(run func(ctx context.Context, projectID int, branch string, reqOpts ...RequestOption)) *MockBranches_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		branch, _ := args[2].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, branch, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Get call
+func (_c *MockBranches_Get_Call) Return(_a0 Branch, _a1 error) *MockBranches_Get_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockBranches_List_Call is an expectation of List call
+type MockBranches_List_Call struct {
+	*mock.Call
+}
+
+// List sets expectation of List call, arguments are values or argument matchers
+func (_e *MockBranches_Expecter) List(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockBranches_List_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockBranches_List_Call{Call: _e.mock.On("List", _ca...)}
+}
+
+// Run sets function called with arguments of List call
+func (_c *MockBranches_List_Call) Run(run func(ctx context.Context, projectID int, opts ListBranchesOptions, reqOpts ...RequestOption)) *MockBranches_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(ListBranchesOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by List call
+func (_c *MockBranches_List_Call) Return(_a0 []Branch, _a1 error) *MockBranches_List_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
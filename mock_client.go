@@ -1,29 +1,81 @@
-// Code generated by mockery v1.0.0. DO NOT EDIT.
+// Code generated by mockgen. DO NOT EDIT.
 
 package gitlab
 
-import context "context"
-import mock "github.com/stretchr/testify/mock"
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Client = (*MockClient)(nil)
 
 // MockClient is an autogenerated mock type for the Client type
 type MockClient struct {
 	mock.Mock
 }
 
-// GetDiscussion provides a mock function with given fields: ctx, projectID, mrID, discussionID
-func (_m *MockClient) GetDiscussion(ctx context.Context, projectID int, mrID int, discussionID string) (Discussion, error) {
-	ret := _m.Called(ctx, projectID, mrID, discussionID)
+// AwardEmojis provides a mock function with given fields:
+func (_m *MockClient) AwardEmojis() AwardEmojis {
+	ret := _m.Called()
+
+	var r0 AwardEmojis
+	if rf, ok := ret.Get(0).(func() AwardEmojis); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(AwardEmojis)
+	}
+
+	return r0
+}
+
+// Branches provides a mock function with given fields:
+func (_m *MockClient) Branches() Branches {
+	ret := _m.Called()
+
+	var r0 Branches
+	if rf, ok := ret.Get(0).(func() Branches); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(Branches)
+	}
+
+	return r0
+}
+
+// Discussions provides a mock function with given fields:
+func (_m *MockClient) Discussions() Discussions {
+	ret := _m.Called()
+
+	var r0 Discussions
+	if rf, ok := ret.Get(0).(func() Discussions); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(Discussions)
+	}
+
+	return r0
+}
+
+// GetDiscussion provides a mock function with given fields: ctx, projectID, mrID, discussionID, reqOpts
+func (_m *MockClient) GetDiscussion(ctx context.Context, projectID int, mrID int, discussionID string, reqOpts ...RequestOption) (Discussion, error) {
+	_ca := []interface{}{ctx, projectID, mrID, discussionID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
 
 	var r0 Discussion
-	if rf, ok := ret.Get(0).(func(context.Context, int, int, string) Discussion); ok {
-		r0 = rf(ctx, projectID, mrID, discussionID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string, ...RequestOption) Discussion); ok {
+		r0 = rf(ctx, projectID, mrID, discussionID, reqOpts...)
 	} else {
 		r0 = ret.Get(0).(Discussion)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, int, int, string) error); ok {
-		r1 = rf(ctx, projectID, mrID, discussionID)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, string, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, discussionID, reqOpts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -31,13 +83,18 @@ func (_m *MockClient) GetDiscussion(ctx context.Context, projectID int, mrID int
 	return r0, r1
 }
 
-// GetParticipants provides a mock function with given fields: ctx, projectID, mrID, discussionID
-func (_m *MockClient) GetParticipants(ctx context.Context, projectID int, mrID int, discussionID string) ([]NoteAuthor, error) {
-	ret := _m.Called(ctx, projectID, mrID, discussionID)
+// GetParticipants provides a mock function with given fields: ctx, projectID, mrID, discussionID, reqOpts
+func (_m *MockClient) GetParticipants(ctx context.Context, projectID int, mrID int, discussionID string, reqOpts ...RequestOption) ([]NoteAuthor, error) {
+	_ca := []interface{}{ctx, projectID, mrID, discussionID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
 
 	var r0 []NoteAuthor
-	if rf, ok := ret.Get(0).(func(context.Context, int, int, string) []NoteAuthor); ok {
-		r0 = rf(ctx, projectID, mrID, discussionID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string, ...RequestOption) []NoteAuthor); ok {
+		r0 = rf(ctx, projectID, mrID, discussionID, reqOpts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]NoteAuthor)
@@ -45,8 +102,8 @@ func (_m *MockClient) GetParticipants(ctx context.Context, projectID int, mrID i
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, int, int, string) error); ok {
-		r1 = rf(ctx, projectID, mrID, discussionID)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, string, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, discussionID, reqOpts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -54,23 +111,748 @@ func (_m *MockClient) GetParticipants(ctx context.Context, projectID int, mrID i
 	return r0, r1
 }
 
-// GetUserByID provides a mock function with given fields: ctx, userID
-func (_m *MockClient) GetUserByID(ctx context.Context, userID int) (User, error) {
-	ret := _m.Called(ctx, userID)
+// GetUserByID provides a mock function with given fields: ctx, userID, reqOpts
+func (_m *MockClient) GetUserByID(ctx context.Context, userID int, reqOpts ...RequestOption) (User, error) {
+	_ca := []interface{}{ctx, userID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
 
 	var r0 User
-	if rf, ok := ret.Get(0).(func(context.Context, int) User); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, ...RequestOption) User); ok {
+		r0 = rf(ctx, userID, reqOpts...)
 	} else {
 		r0 = ret.Get(0).(User)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, userID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUsersByIDs provides a mock function with given fields: ctx, ids, reqOpts
+func (_m *MockClient) GetUsersByIDs(ctx context.Context, ids []int, reqOpts ...RequestOption) ([]User, error) {
+	_ca := []interface{}{ctx, ids}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(context.Context, []int, ...RequestOption) []User); ok {
+		r0 = rf(ctx, ids, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int, ...RequestOption) error); ok {
+		r1 = rf(ctx, ids, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Groups provides a mock function with given fields:
+func (_m *MockClient) Groups() Groups {
+	ret := _m.Called()
+
+	var r0 Groups
+	if rf, ok := ret.Get(0).(func() Groups); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(Groups)
+	}
+
+	return r0
+}
+
+// Instance provides a mock function with given fields:
+func (_m *MockClient) Instance() Instance {
+	ret := _m.Called()
+
+	var r0 Instance
+	if rf, ok := ret.Get(0).(func() Instance); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(Instance)
+	}
+
+	return r0
+}
+
+// MergeRequests provides a mock function with given fields:
+func (_m *MockClient) MergeRequests() MergeRequests {
+	ret := _m.Called()
+
+	var r0 MergeRequests
+	if rf, ok := ret.Get(0).(func() MergeRequests); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(MergeRequests)
+	}
+
+	return r0
+}
+
+// Projects provides a mock function with given fields:
+func (_m *MockClient) Projects() Projects {
+	ret := _m.Called()
+
+	var r0 Projects
+	if rf, ok := ret.Get(0).(func() Projects); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(Projects)
+	}
+
+	return r0
+}
+
+// ProtectedBranches provides a mock function with given fields:
+func (_m *MockClient) ProtectedBranches() ProtectedBranches {
+	ret := _m.Called()
+
+	var r0 ProtectedBranches
+	if rf, ok := ret.Get(0).(func() ProtectedBranches); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(ProtectedBranches)
+	}
+
+	return r0
+}
+
+// ProtectedTags provides a mock function with given fields:
+func (_m *MockClient) ProtectedTags() ProtectedTags {
+	ret := _m.Called()
+
+	var r0 ProtectedTags
+	if rf, ok := ret.Get(0).(func() ProtectedTags); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(ProtectedTags)
+	}
+
+	return r0
+}
+
+// Repository provides a mock function with given fields:
+func (_m *MockClient) Repository() Repository {
+	ret := _m.Called()
+
+	var r0 Repository
+	if rf, ok := ret.Get(0).(func() Repository); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(Repository)
+	}
+
+	return r0
+}
+
+// SendRequest provides a mock function with given fields: ctx, method, path, data, reqOpts
+func (_m *MockClient) SendRequest(ctx context.Context, method string, path string, data []byte, reqOpts ...RequestOption) ([]byte, error) {
+	_ca := []interface{}{ctx, method, path, data}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte, ...RequestOption) []byte); ok {
+		r0 = rf(ctx, method, path, data, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []byte, ...RequestOption) error); ok {
+		r1 = rf(ctx, method, path, data, reqOpts...)
 	} else {
 		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
+
+// SystemHooks provides a mock function with given fields:
+func (_m *MockClient) SystemHooks() SystemHooks {
+	ret := _m.Called()
+
+	var r0 SystemHooks
+	if rf, ok := ret.Get(0).(func() SystemHooks); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(SystemHooks)
+	}
+
+	return r0
+}
+
+// Tags provides a mock function with given fields:
+func (_m *MockClient) Tags() Tags {
+	ret := _m.Called()
+
+	var r0 Tags
+	if rf, ok := ret.Get(0).(func() Tags); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(Tags)
+	}
+
+	return r0
+}
+
+// Users provides a mock function with given fields:
+func (_m *MockClient) Users() Users {
+	ret := _m.Called()
+
+	var r0 Users
+	if rf, ok := ret.Get(0).(func() Users); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(Users)
+	}
+
+	return r0
+}
+
+// MockClient_Expecter provides typed helpers to set expectations
+type MockClient_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockClient) EXPECT() *MockClient_Expecter {
+	return &MockClient_Expecter{mock: &_m.Mock}
+}
+
+// MockClient_AwardEmojis_Call is an expectation of AwardEmojis call
+type MockClient_AwardEmojis_Call struct {
+	*mock.Call
+}
+
+// AwardEmojis sets expectation of AwardEmojis call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) AwardEmojis() *MockClient_AwardEmojis_Call {
+	return &MockClient_AwardEmojis_Call{Call: _e.mock.On("AwardEmojis")}
+}
+
+// Run sets function called with arguments of AwardEmojis call
+func (_c *MockClient_AwardEmojis_Call) Run(run func()) *MockClient_AwardEmojis_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+
+	return _c
+}
+
+// Return sets values returned by AwardEmojis call
+func (_c *MockClient_AwardEmojis_Call) Return(_a0 AwardEmojis) *MockClient_AwardEmojis_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockClient_Branches_Call is an expectation of Branches call
+type MockClient_Branches_Call struct {
+	*mock.Call
+}
+
+// Branches sets expectation of Branches call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) Branches() *MockClient_Branches_Call {
+	return &MockClient_Branches_Call{Call: _e.mock.On("Branches")}
+}
+
+// Run sets function called with arguments of Branches call
+func (_c *MockClient_Branches_Call) Run(run func()) *MockClient_Branches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+
+	return _c
+}
+
+// Return sets values returned by Branches call
+func (_c *MockClient_Branches_Call) Return(_a0 Branches) *MockClient_Branches_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockClient_Discussions_Call is an expectation of Discussions call
+type MockClient_Discussions_Call struct {
+	*mock.Call
+}
+
+// Discussions sets expectation of Discussions call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) Discussions() *MockClient_Discussions_Call {
+	return &MockClient_Discussions_Call{Call: _e.mock.On("Discussions")}
+}
+
+// Run sets function called with arguments of Discussions call
+func (_c *MockClient_Discussions_Call) Run(run func()) *MockClient_Discussions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+
+	return _c
+}
+
+// Return sets values returned by Discussions call
+func (_c *MockClient_Discussions_Call) Return(_a0 Discussions) *MockClient_Discussions_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockClient_GetDiscussion_Call is an expectation of GetDiscussion call
+type MockClient_GetDiscussion_Call struct {
+	*mock.Call
+}
+
+// GetDiscussion sets expectation of GetDiscussion call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) GetDiscussion(ctx interface{}, projectID interface{}, mrID interface{}, discussionID interface{}, reqOpts ...interface{}) *MockClient_GetDiscussion_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, discussionID}, reqOpts...)
+	return &MockClient_GetDiscussion_Call{Call: _e.mock.On("GetDiscussion", _ca...)}
+}
+
+// Run sets function called with arguments of GetDiscussion call
+func (_c *MockClient_GetDiscussion_Call) Run(run func(ctx context.Context, projectID int, mrID int, discussionID string, reqOpts ...RequestOption)) *MockClient_GetDiscussion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		discussionID, _ := args[3].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, discussionID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetDiscussion call
+func (_c *MockClient_GetDiscussion_Call) Return(_a0 Discussion, _a1 error) *MockClient_GetDiscussion_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockClient_GetParticipants_Call is an expectation of GetParticipants call
+type MockClient_GetParticipants_Call struct {
+	*mock.Call
+}
+
+// GetParticipants sets expectation of GetParticipants call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) GetParticipants(ctx interface{}, projectID interface{}, mrID interface{}, discussionID interface{}, reqOpts ...interface{}) *MockClient_GetParticipants_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, discussionID}, reqOpts...)
+	return &MockClient_GetParticipants_Call{Call: _e.mock.On("GetParticipants", _ca...)}
+}
+
+// Run sets function called with arguments of GetParticipants call
+func (_c *MockClient_GetParticipants_Call) Run(run func(ctx context.Context, projectID int, mrID int, discussionID string, reqOpts ...RequestOption)) *MockClient_GetParticipants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		discussionID, _ := args[3].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, discussionID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetParticipants call
+func (_c *MockClient_GetParticipants_Call) Return(_a0 []NoteAuthor, _a1 error) *MockClient_GetParticipants_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockClient_GetUserByID_Call is an expectation of GetUserByID call
+type MockClient_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID sets expectation of GetUserByID call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) GetUserByID(ctx interface{}, userID interface{}, reqOpts ...interface{}) *MockClient_GetUserByID_Call {
+	_ca := append([]interface{}{ctx, userID}, reqOpts...)
+	return &MockClient_GetUserByID_Call{Call: _e.mock.On("GetUserByID", _ca...)}
+}
+
+// Run sets function called with arguments of GetUserByID call
+func (_c *MockClient_GetUserByID_Call) Run(run func(ctx context.Context, userID int, reqOpts ...RequestOption)) *MockClient_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		userID, _ := args[1].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-2)
+		for _, _a := range args[2:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, userID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetUserByID call
+func (_c *MockClient_GetUserByID_Call) Return(_a0 User, _a1 error) *MockClient_GetUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockClient_GetUsersByIDs_Call is an expectation of GetUsersByIDs call
+type MockClient_GetUsersByIDs_Call struct {
+	*mock.Call
+}
+
+// GetUsersByIDs sets expectation of GetUsersByIDs call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) GetUsersByIDs(ctx interface{}, ids interface{}, reqOpts ...interface{}) *MockClient_GetUsersByIDs_Call {
+	_ca := append([]interface{}{ctx, ids}, reqOpts...)
+	return &MockClient_GetUsersByIDs_Call{Call: _e.mock.On("GetUsersByIDs", _ca...)}
+}
+
+// Run sets function called with arguments of GetUsersByIDs call
+func (_c *MockClient_GetUsersByIDs_Call) Run(run func(ctx context.Context, ids []int, reqOpts ...RequestOption)) *MockClient_GetUsersByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		ids, _ := args[1].([]int)
+		reqOpts := make([]RequestOption, 0, len(args)-2)
+		for _, _a := range args[2:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, ids, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetUsersByIDs call
+func (_c *MockClient_GetUsersByIDs_Call) Return(_a0 []User, _a1 error) *MockClient_GetUsersByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockClient_Groups_Call is an expectation of Groups call
+type MockClient_Groups_Call struct {
+	*mock.Call
+}
+
+// Groups sets expectation of Groups call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) Groups() *MockClient_Groups_Call {
+	return &MockClient_Groups_Call{Call: _e.mock.On("Groups")}
+}
+
+// Run sets function called with arguments of Groups call
+func (_c *MockClient_Groups_Call) Run(run func()) *MockClient_Groups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+
+	return _c
+}
+
+// Return sets values returned by Groups call
+func (_c *MockClient_Groups_Call) Return(_a0 Groups) *MockClient_Groups_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockClient_Instance_Call is an expectation of Instance call
+type MockClient_Instance_Call struct {
+	*mock.Call
+}
+
+// Instance sets expectation of Instance call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) Instance() *MockClient_Instance_Call {
+	return &MockClient_Instance_Call{Call: _e.mock.On("Instance")}
+}
+
+// Run sets function called with arguments of Instance call
+func (_c *MockClient_Instance_Call) Run(run func()) *MockClient_Instance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+
+	return _c
+}
+
+// Return sets values returned by Instance call
+func (_c *MockClient_Instance_Call) Return(_a0 Instance) *MockClient_Instance_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockClient_MergeRequests_Call is an expectation of MergeRequests call
+type MockClient_MergeRequests_Call struct {
+	*mock.Call
+}
+
+// MergeRequests sets expectation of MergeRequests call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) MergeRequests() *MockClient_MergeRequests_Call {
+	return &MockClient_MergeRequests_Call{Call: _e.mock.On("MergeRequests")}
+}
+
+// Run sets function called with arguments of MergeRequests call
+func (_c *MockClient_MergeRequests_Call) Run(run func()) *MockClient_MergeRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+
+	return _c
+}
+
+// Return sets values returned by MergeRequests call
+func (_c *MockClient_MergeRequests_Call) Return(_a0 MergeRequests) *MockClient_MergeRequests_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockClient_Projects_Call is an expectation of Projects call
+type MockClient_Projects_Call struct {
+	*mock.Call
+}
+
+// Projects sets expectation of Projects call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) Projects() *MockClient_Projects_Call {
+	return &MockClient_Projects_Call{Call: _e.mock.On("Projects")}
+}
+
+// Run sets function called with arguments of Projects call
+func (_c *MockClient_Projects_Call) Run(run func()) *MockClient_Projects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+
+	return _c
+}
+
+// Return sets values returned by Projects call
+func (_c *MockClient_Projects_Call) Return(_a0 Projects) *MockClient_Projects_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockClient_ProtectedBranches_Call is an expectation of ProtectedBranches call
+type MockClient_ProtectedBranches_Call struct {
+	*mock.Call
+}
+
+// ProtectedBranches sets expectation of ProtectedBranches call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) ProtectedBranches() *MockClient_ProtectedBranches_Call {
+	return &MockClient_ProtectedBranches_Call{Call: _e.mock.On("ProtectedBranches")}
+}
+
+// Run sets function called with arguments of ProtectedBranches call
+func (_c *MockClient_ProtectedBranches_Call) Run(run func()) *MockClient_ProtectedBranches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+
+	return _c
+}
+
+// Return sets values returned by ProtectedBranches call
+func (_c *MockClient_ProtectedBranches_Call) Return(_a0 ProtectedBranches) *MockClient_ProtectedBranches_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockClient_ProtectedTags_Call is an expectation of ProtectedTags call
+type MockClient_ProtectedTags_Call struct {
+	*mock.Call
+}
+
+// ProtectedTags sets expectation of ProtectedTags call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) ProtectedTags() *MockClient_ProtectedTags_Call {
+	return &MockClient_ProtectedTags_Call{Call: _e.mock.On("ProtectedTags")}
+}
+
+// Run sets function called with arguments of ProtectedTags call
+func (_c *MockClient_ProtectedTags_Call) Run(run func()) *MockClient_ProtectedTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+
+	return _c
+}
+
+// Return sets values returned by ProtectedTags call
+func (_c *MockClient_ProtectedTags_Call) Return(_a0 ProtectedTags) *MockClient_ProtectedTags_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockClient_Repository_Call is an expectation of Repository call
+type MockClient_Repository_Call struct {
+	*mock.Call
+}
+
+// Repository sets expectation of Repository call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) Repository() *MockClient_Repository_Call {
+	return &MockClient_Repository_Call{Call: _e.mock.On("Repository")}
+}
+
+// Run sets function called with arguments of Repository call
+func (_c *MockClient_Repository_Call) Run(run func()) *MockClient_Repository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+
+	return _c
+}
+
+// Return sets values returned by Repository call
+func (_c *MockClient_Repository_Call) Return(_a0 Repository) *MockClient_Repository_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockClient_SendRequest_Call is an expectation of SendRequest call
+type MockClient_SendRequest_Call struct {
+	*mock.Call
+}
+
+// SendRequest sets expectation of SendRequest call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) SendRequest(ctx interface{}, method interface{}, path interface{}, data interface{}, reqOpts ...interface{}) *MockClient_SendRequest_Call {
+	_ca := append([]interface{}{ctx, method, path, data}, reqOpts...)
+	return &MockClient_SendRequest_Call{Call: _e.mock.On("SendRequest", _ca...)}
+}
+
+// Run sets function called with arguments of SendRequest call
+func (_c *MockClient_SendRequest_Call) Run(run func(ctx context.Context, method string, path string, data []byte, reqOpts ...RequestOption)) *MockClient_SendRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		method, _ := args[1].(string)
+		path, _ := args[2].(string)
+		data, _ := args[3].([]byte)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, method, path, data, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by SendRequest call
+func (_c *MockClient_SendRequest_Call) Return(_a0 []byte, _a1 error) *MockClient_SendRequest_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockClient_SystemHooks_Call is an expectation of SystemHooks call
+type MockClient_SystemHooks_Call struct {
+	*mock.Call
+}
+
+// SystemHooks sets expectation of SystemHooks call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) SystemHooks() *MockClient_SystemHooks_Call {
+	return &MockClient_SystemHooks_Call{Call: _e.mock.On("SystemHooks")}
+}
+
+// Run sets function called with arguments of SystemHooks call
+func (_c *MockClient_SystemHooks_Call) Run(run func()) *MockClient_SystemHooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+
+	return _c
+}
+
+// Return sets values returned by SystemHooks call
+func (_c *MockClient_SystemHooks_Call) Return(_a0 SystemHooks) *MockClient_SystemHooks_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockClient_Tags_Call is an expectation of Tags call
+type MockClient_Tags_Call struct {
+	*mock.Call
+}
+
+// Tags sets expectation of Tags call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) Tags() *MockClient_Tags_Call {
+	return &MockClient_Tags_Call{Call: _e.mock.On("Tags")}
+}
+
+// Run sets function called with arguments of Tags call
+func (_c *MockClient_Tags_Call) Run(run func()) *MockClient_Tags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+
+	return _c
+}
+
+// Return sets values returned by Tags call
+func (_c *MockClient_Tags_Call) Return(_a0 Tags) *MockClient_Tags_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockClient_Users_Call is an expectation of Users call
+type MockClient_Users_Call struct {
+	*mock.Call
+}
+
+// Users sets expectation of Users call, arguments are values or argument matchers
+func (_e *MockClient_Expecter) Users() *MockClient_Users_Call {
+	return &MockClient_Users_Call{Call: _e.mock.On("Users")}
+}
+
+// Run sets function called with arguments of Users call
+func (_c *MockClient_Users_Call) Run(run func()) *MockClient_Users_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+
+	return _c
+}
+
+// Return sets values returned by Users call
+func (_c *MockClient_Users_Call) Return(_a0 Users) *MockClient_Users_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
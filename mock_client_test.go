@@ -0,0 +1,69 @@
+package gitlab_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestMockClient(t *testing.T) {
+	t.Run("typed expectations", func(t *testing.T) {
+		client := new(gitlab.MockClient)
+
+		var calledWith []int
+		client.EXPECT().GetUsersByIDs(mock.Anything, []int{1, 2}).
+			Run(func(ctx context.Context, ids []int, _ ...gitlab.RequestOption) {
+				calledWith = ids
+			}).
+			Return([]gitlab.User{{ID: 1}, {ID: 2}}, nil).
+			Once()
+
+		branches := new(gitlab.MockBranches)
+		branches.EXPECT().Delete(mock.Anything, 10, "feature").Return(errors.New("test error"))
+		client.EXPECT().Branches().Return(branches)
+
+		var c gitlab.Client = client
+
+		users, err := c.GetUsersByIDs(context.Background(), []int{1, 2})
+		assert.NoError(t, err)
+		assert.Equal(t, []gitlab.User{{ID: 1}, {ID: 2}}, users)
+		assert.Equal(t, []int{1, 2}, calledWith)
+
+		assert.EqualError(t, c.Branches().Delete(context.Background(), 10, "feature"), "test error")
+
+		client.AssertExpectations(t)
+		branches.AssertExpectations(t)
+	})
+
+	t.Run("request options", func(t *testing.T) {
+		client := new(gitlab.MockClient)
+
+		var options []gitlab.RequestOption
+		client.EXPECT().GetUserByID(mock.Anything, 5, mock.Anything).
+			Run(func(ctx context.Context, userID int, reqOpts ...gitlab.RequestOption) {
+				options = reqOpts
+			}).
+			Return(gitlab.User{ID: 5}, nil)
+
+		user, err := client.GetUserByID(context.Background(), 5, gitlab.WithSudo("root"))
+		assert.NoError(t, err)
+		assert.Equal(t, 5, user.ID)
+		assert.Equal(t, []gitlab.RequestOption{gitlab.WithSudo("root")}, options)
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("nil results", func(t *testing.T) {
+		client := new(gitlab.MockClient)
+		client.EXPECT().SendRequest(mock.Anything, "GET", "version", mock.Anything).Return(nil, nil)
+
+		resp, err := client.SendRequest(context.Background(), "GET", "version", nil)
+		assert.NoError(t, err)
+		assert.Nil(t, resp)
+	})
+}
@@ -0,0 +1,216 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Discussions = (*MockDiscussions)(nil)
+
+// MockDiscussions is an autogenerated mock type for the Discussions type
+type MockDiscussions struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, projectID, mrID, discussionID, reqOpts
+func (_m *MockDiscussions) Get(ctx context.Context, projectID int, mrID int, discussionID string, reqOpts ...RequestOption) (Discussion, error) {
+	_ca := []interface{}{ctx, projectID, mrID, discussionID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Discussion
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string, ...RequestOption) Discussion); ok {
+		r0 = rf(ctx, projectID, mrID, discussionID, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Discussion)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, string, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, discussionID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetParticipants provides a mock function with given fields: ctx, projectID, mrID, discussionID, reqOpts
+func (_m *MockDiscussions) GetParticipants(ctx context.Context, projectID int, mrID int, discussionID string, reqOpts ...RequestOption) ([]NoteAuthor, error) {
+	_ca := []interface{}{ctx, projectID, mrID, discussionID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []NoteAuthor
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string, ...RequestOption) []NoteAuthor); ok {
+		r0 = rf(ctx, projectID, mrID, discussionID, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NoteAuthor)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, string, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, discussionID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, projectID, mrID, opts, reqOpts
+func (_m *MockDiscussions) List(ctx context.Context, projectID int, mrID int, opts ListOptions, reqOpts ...RequestOption) ([]Discussion, error) {
+	_ca := []interface{}{ctx, projectID, mrID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []Discussion
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ListOptions, ...RequestOption) []Discussion); ok {
+		r0 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Discussion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ListOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDiscussions_Expecter provides typed helpers to set expectations
+type MockDiscussions_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockDiscussions) EXPECT() *MockDiscussions_Expecter {
+	return &MockDiscussions_Expecter{mock: &_m.Mock}
+}
+
+// MockDiscussions_Get_Call is an expectation of Get call
+type MockDiscussions_Get_Call struct {
+	*mock.Call
+}
+
+// Get sets expectation of Get call, arguments are values or argument matchers
+func (_e *MockDiscussions_Expecter) Get(ctx interface{}, projectID interface{}, mrID interface{}, discussionID interface{}, reqOpts ...interface{}) *MockDiscussions_Get_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, discussionID}, reqOpts...)
+	return &MockDiscussions_Get_Call{Call: _e.mock.On("Get", _ca...)}
+}
+
+// Run sets function called with arguments of Get call
+func (_c *MockDiscussions_Get_Call) Run(run func(ctx context.Context, projectID int, mrID int, discussionID string, reqOpts ...RequestOption)) *MockDiscussions_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		discussionID, _ := args[3].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, discussionID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Get call
+func (_c *MockDiscussions_Get_Call) Return(_a0 Discussion, _a1 error) *MockDiscussions_Get_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockDiscussions_GetParticipants_Call is an expectation of GetParticipants call
+type MockDiscussions_GetParticipants_Call struct {
+	*mock.Call
+}
+
+// GetParticipants sets expectation of GetParticipants call, arguments are values or argument matchers
+func (_e *MockDiscussions_Expecter) GetParticipants(ctx interface{}, projectID interface{}, mrID interface{}, discussionID interface{}, reqOpts ...interface{}) *MockDiscussions_GetParticipants_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, discussionID}, reqOpts...)
+	return &MockDiscussions_GetParticipants_Call{Call: _e.mock.On("GetParticipants", _ca...)}
+}
+
+// Run sets function called with arguments of GetParticipants call
+func (_c *MockDiscussions_GetParticipants_Call) Run(run func(ctx context.Context, projectID int, mrID int, discussionID string, reqOpts ...RequestOption)) *MockDiscussions_GetParticipants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		discussionID, _ := args[3].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, discussionID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetParticipants call
+func (_c *MockDiscussions_GetParticipants_Call) Return(_a0 []NoteAuthor, _a1 error) *MockDiscussions_GetParticipants_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockDiscussions_List_Call is an expectation of List call
+type MockDiscussions_List_Call struct {
+	*mock.Call
+}
+
+// List sets expectation of List call, arguments are values or argument matchers
+func (_e *MockDiscussions_Expecter) List(ctx interface{}, projectID interface{}, mrID interface{}, opts interface{}, reqOpts ...interface{}) *MockDiscussions_List_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, opts}, reqOpts...)
+	return &MockDiscussions_List_Call{Call: _e.mock.On("List", _ca...)}
+}
+
+// Run sets function called with arguments of List call
+func (_c *MockDiscussions_List_Call) Run(run func(ctx context.Context, projectID int, mrID int, opts ListOptions, reqOpts ...RequestOption)) *MockDiscussions_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		opts, _ := args[3].(ListOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by List call
+func (_c *MockDiscussions_List_Call) Return(_a0 []Discussion, _a1 error) *MockDiscussions_List_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
@@ -0,0 +1,493 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Groups = (*MockGroups)(nil)
+
+// MockGroups is an autogenerated mock type for the Groups type
+type MockGroups struct {
+	mock.Mock
+}
+
+// AddHook provides a mock function with given fields: ctx, groupID, opts, reqOpts
+func (_m *MockGroups) AddHook(ctx context.Context, groupID int, opts HookOptions, reqOpts ...RequestOption) (Hook, error) {
+	_ca := []interface{}{ctx, groupID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Hook
+	if rf, ok := ret.Get(0).(func(context.Context, int, HookOptions, ...RequestOption) Hook); ok {
+		r0 = rf(ctx, groupID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Hook)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, HookOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, groupID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteHook provides a mock function with given fields: ctx, groupID, hookID, reqOpts
+func (_m *MockGroups) DeleteHook(ctx context.Context, groupID int, hookID int, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, groupID, hookID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) error); ok {
+		r0 = rf(ctx, groupID, hookID, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteHookUrlVariable provides a mock function with given fields: ctx, groupID, hookID, key, reqOpts
+func (_m *MockGroups) DeleteHookUrlVariable(ctx context.Context, groupID int, hookID int, key string, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, groupID, hookID, key}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string, ...RequestOption) error); ok {
+		r0 = rf(ctx, groupID, hookID, key, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EditHook provides a mock function with given fields: ctx, groupID, hookID, opts, reqOpts
+func (_m *MockGroups) EditHook(ctx context.Context, groupID int, hookID int, opts HookOptions, reqOpts ...RequestOption) (Hook, error) {
+	_ca := []interface{}{ctx, groupID, hookID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Hook
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, HookOptions, ...RequestOption) Hook); ok {
+		r0 = rf(ctx, groupID, hookID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Hook)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, HookOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, groupID, hookID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHook provides a mock function with given fields: ctx, groupID, hookID, reqOpts
+func (_m *MockGroups) GetHook(ctx context.Context, groupID int, hookID int, reqOpts ...RequestOption) (Hook, error) {
+	_ca := []interface{}{ctx, groupID, hookID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Hook
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) Hook); ok {
+		r0 = rf(ctx, groupID, hookID, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Hook)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, groupID, hookID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListHooks provides a mock function with given fields: ctx, groupID, opts, reqOpts
+func (_m *MockGroups) ListHooks(ctx context.Context, groupID int, opts ListOptions, reqOpts ...RequestOption) ([]Hook, error) {
+	_ca := []interface{}{ctx, groupID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []Hook
+	if rf, ok := ret.Get(0).(func(context.Context, int, ListOptions, ...RequestOption) []Hook); ok {
+		r0 = rf(ctx, groupID, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Hook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ListOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, groupID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetHookUrlVariable provides a mock function with given fields: ctx, groupID, hookID, key, value, reqOpts
+func (_m *MockGroups) SetHookUrlVariable(ctx context.Context, groupID int, hookID int, key string, value string, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, groupID, hookID, key, value}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string, string, ...RequestOption) error); ok {
+		r0 = rf(ctx, groupID, hookID, key, value, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TestHook provides a mock function with given fields: ctx, groupID, hookID, trigger, reqOpts
+func (_m *MockGroups) TestHook(ctx context.Context, groupID int, hookID int, trigger HookTrigger, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, groupID, hookID, trigger}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, HookTrigger, ...RequestOption) error); ok {
+		r0 = rf(ctx, groupID, hookID, trigger, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGroups_Expecter provides typed helpers to set expectations
+type MockGroups_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockGroups) EXPECT() *MockGroups_Expecter {
+	return &MockGroups_Expecter{mock: &_m.Mock}
+}
+
+// MockGroups_AddHook_Call is an expectation of AddHook call
+type MockGroups_AddHook_Call struct {
+	*mock.Call
+}
+
+// AddHook sets expectation of AddHook call, arguments are values or argument matchers
+func (_e *MockGroups_Expecter) AddHook(ctx interface{}, groupID interface{}, opts interface{}, reqOpts ...interface{}) *MockGroups_AddHook_Call {
+	_ca := append([]interface{}{ctx, groupID, opts}, reqOpts...)
+	return &MockGroups_AddHook_Call{Call: _e.mock.On("AddHook", _ca...)}
+}
+
+// Run sets function called with arguments of AddHook call
+func (_c *MockGroups_AddHook_Call) Run(run func(ctx context.Context, groupID int, opts HookOptions, reqOpts ...RequestOption)) *MockGroups_AddHook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		groupID, _ := args[1].(int)
+		opts, _ := args[2].(HookOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, groupID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by AddHook call
+func (_c *MockGroups_AddHook_Call) Return(_a0 Hook, _a1 error) *MockGroups_AddHook_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockGroups_DeleteHook_Call is an expectation of DeleteHook call
+type MockGroups_DeleteHook_Call struct {
+	*mock.Call
+}
+
+// DeleteHook sets expectation of DeleteHook call, arguments are values or argument matchers
+func (_e *MockGroups_Expecter) DeleteHook(ctx interface{}, groupID interface{}, hookID interface{}, reqOpts ...interface{}) *MockGroups_DeleteHook_Call {
+	_ca := append([]interface{}{ctx, groupID, hookID}, reqOpts...)
+	return &MockGroups_DeleteHook_Call{Call: _e.mock.On("DeleteHook", _ca...)}
+}
+
+// Run sets function called with arguments of DeleteHook call
+func (_c *MockGroups_DeleteHook_Call) Run(run func(ctx context.Context, groupID int, hookID int, reqOpts ...RequestOption)) *MockGroups_DeleteHook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		groupID, _ := args[1].(int)
+		hookID, _ := args[2].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, groupID, hookID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by DeleteHook call
+func (_c *MockGroups_DeleteHook_Call) Return(_a0 error) *MockGroups_DeleteHook_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockGroups_DeleteHookUrlVariable_Call is an expectation of DeleteHookUrlVariable call
+type MockGroups_DeleteHookUrlVariable_Call struct {
+	*mock.Call
+}
+
+// DeleteHookUrlVariable sets expectation of DeleteHookUrlVariable call, arguments are values or argument matchers
+func (_e *MockGroups_Expecter) DeleteHookUrlVariable(ctx interface{}, groupID interface{}, hookID interface{}, key interface{}, reqOpts ...interface{}) *MockGroups_DeleteHookUrlVariable_Call {
+	_ca := append([]interface{}{ctx, groupID, hookID, key}, reqOpts...)
+	return &MockGroups_DeleteHookUrlVariable_Call{Call: _e.mock.On("DeleteHookUrlVariable", _ca...)}
+}
+
+// Run sets function called with arguments of DeleteHookUrlVariable call
+func (_c *MockGroups_DeleteHookUrlVariable_Call) Run(run func(ctx context.Context, groupID int, hookID int, key string, reqOpts ...RequestOption)) *MockGroups_DeleteHookUrlVariable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		groupID, _ := args[1].(int)
+		hookID, _ := args[2].(int)
+		key, _ := args[3].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, groupID, hookID, key, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by DeleteHookUrlVariable call
+func (_c *MockGroups_DeleteHookUrlVariable_Call) Return(_a0 error) *MockGroups_DeleteHookUrlVariable_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockGroups_EditHook_Call is an expectation of EditHook call
+type MockGroups_EditHook_Call struct {
+	*mock.Call
+}
+
+// EditHook sets expectation of EditHook call, arguments are values or argument matchers
+func (_e *MockGroups_Expecter) EditHook(ctx interface{}, groupID interface{}, hookID interface{}, opts interface{}, reqOpts ...interface{}) *MockGroups_EditHook_Call {
+	_ca := append([]interface{}{ctx, groupID, hookID, opts}, reqOpts...)
+	return &MockGroups_EditHook_Call{Call: _e.mock.On("EditHook", _ca...)}
+}
+
+// Run sets function called with arguments of EditHook call
+func (_c *MockGroups_EditHook_Call) Run(run func(ctx context.Context, groupID int, hookID int, opts HookOptions, reqOpts ...RequestOption)) *MockGroups_EditHook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		groupID, _ := args[1].(int)
+		hookID, _ := args[2].(int)
+		opts, _ := args[3].(HookOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, groupID, hookID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by EditHook call
+func (_c *MockGroups_EditHook_Call) Return(_a0 Hook, _a1 error) *MockGroups_EditHook_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockGroups_GetHook_Call is an expectation of GetHook call
+type MockGroups_GetHook_Call struct {
+	*mock.Call
+}
+
+// GetHook sets expectation of GetHook call, arguments are values or argument matchers
+func (_e *MockGroups_Expecter) GetHook(ctx interface{}, groupID interface{}, hookID interface{}, reqOpts ...interface{}) *MockGroups_GetHook_Call {
+	_ca := append([]interface{}{ctx, groupID, hookID}, reqOpts...)
+	return &MockGroups_GetHook_Call{Call: _e.mock.On("GetHook", _ca...)}
+}
+
+// Run sets function called with arguments of GetHook call
+func (_c *MockGroups_GetHook_Call) Run(run func(ctx context.Context, groupID int, hookID int, reqOpts ...RequestOption)) *MockGroups_GetHook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		groupID, _ := args[1].(int)
+		hookID, _ := args[2].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, groupID, hookID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetHook call
+func (_c *MockGroups_GetHook_Call) Return(_a0 Hook, _a1 error) *MockGroups_GetHook_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockGroups_ListHooks_Call is an expectation of ListHooks call
+type MockGroups_ListHooks_Call struct {
+	*mock.Call
+}
+
+// ListHooks sets expectation of ListHooks call, arguments are values or argument matchers
+func (_e *MockGroups_Expecter) ListHooks(ctx interface{}, groupID interface{}, opts interface{}, reqOpts ...interface{}) *MockGroups_ListHooks_Call {
+	_ca := append([]interface{}{ctx, groupID, opts}, reqOpts...)
+	return &MockGroups_ListHooks_Call{Call: _e.mock.On("ListHooks", _ca...)}
+}
+
+// Run sets function called with arguments of ListHooks call
+func (_c *MockGroups_ListHooks_Call) Run(run func(ctx context.Context, groupID int, opts ListOptions, reqOpts ...RequestOption)) *MockGroups_ListHooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		groupID, _ := args[1].(int)
+		opts, _ := args[2].(ListOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, groupID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by ListHooks call
+func (_c *MockGroups_ListHooks_Call) Return(_a0 []Hook, _a1 error) *MockGroups_ListHooks_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockGroups_SetHookUrlVariable_Call is an expectation of SetHookUrlVariable call
+type MockGroups_SetHookUrlVariable_Call struct {
+	*mock.Call
+}
+
+// SetHookUrlVariable sets expectation of SetHookUrlVariable call, arguments are values or argument matchers
+func (_e *MockGroups_Expecter) SetHookUrlVariable(ctx interface{}, groupID interface{}, hookID interface{}, key interface{}, value interface{}, reqOpts ...interface{}) *MockGroups_SetHookUrlVariable_Call {
+	_ca := append([]interface{}{ctx, groupID, hookID, key, value}, reqOpts...)
+	return &MockGroups_SetHookUrlVariable_Call{Call: _e.mock.On("SetHookUrlVariable", _ca...)}
+}
+
+// Run sets function called with arguments of SetHookUrlVariable call
+func (_c *MockGroups_SetHookUrlVariable_Call) Run(run func(ctx context.Context, groupID int, hookID int, key string, value string, reqOpts ...RequestOption)) *MockGroups_SetHookUrlVariable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		groupID, _ := args[1].(int)
+		hookID, _ := args[2].(int)
+		key, _ := args[3].(string)
+		value, _ := args[4].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-5)
+		for _, _a := range args[5:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, groupID, hookID, key, value, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by SetHookUrlVariable call
+func (_c *MockGroups_SetHookUrlVariable_Call) Return(_a0 error) *MockGroups_SetHookUrlVariable_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockGroups_TestHook_Call is an expectation of TestHook call
+type MockGroups_TestHook_Call struct {
+	*mock.Call
+}
+
+// TestHook sets expectation of TestHook call, arguments are values or argument matchers
+func (_e *MockGroups_Expecter) TestHook(ctx interface{}, groupID interface{}, hookID interface{}, trigger interface{}, reqOpts ...interface{}) *MockGroups_TestHook_Call {
+	_ca := append([]interface{}{ctx, groupID, hookID, trigger}, reqOpts...)
+	return &MockGroups_TestHook_Call{Call: _e.mock.On("TestHook", _ca...)}
+}
+
+// Run sets function called with arguments of TestHook call
+func (_c *MockGroups_TestHook_Call) Run(run func(ctx context.Context, groupID int, hookID int, trigger HookTrigger, reqOpts ...RequestOption)) *MockGroups_TestHook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		groupID, _ := args[1].(int)
+		hookID, _ := args[2].(int)
+		trigger, _ := args[3].(HookTrigger)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, groupID, hookID, trigger, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by TestHook call
+func (_c *MockGroups_TestHook_Call) Return(_a0 error) *MockGroups_TestHook_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
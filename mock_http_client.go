@@ -1,9 +1,14 @@
-// Code generated by mockery v1.0.0. DO NOT EDIT.
+// Code generated by mockgen. DO NOT EDIT.
 
 package gitlab
 
-import http "net/http"
-import mock "github.com/stretchr/testify/mock"
+import (
+	"net/http"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ HTTPClient = (*MockHTTPClient)(nil)
 
 // MockHTTPClient is an autogenerated mock type for the HTTPClient type
 type MockHTTPClient struct {
@@ -32,3 +37,40 @@ func (_m *MockHTTPClient) Do(req *http.Request) (*http.Response, error) {
 
 	return r0, r1
 }
+
+// MockHTTPClient_Expecter provides typed helpers to set expectations
+type MockHTTPClient_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockHTTPClient) EXPECT() *MockHTTPClient_Expecter {
+	return &MockHTTPClient_Expecter{mock: &_m.Mock}
+}
+
+// MockHTTPClient_Do_Call is an expectation of Do call
+type MockHTTPClient_Do_Call struct {
+	*mock.Call
+}
+
+// Do sets expectation of Do call, arguments are values or argument matchers
+func (_e *MockHTTPClient_Expecter) Do(req interface{}) *MockHTTPClient_Do_Call {
+	return &MockHTTPClient_Do_Call{Call: _e.mock.On("Do", req)}
+}
+
+// Run sets function called with arguments of Do call
+func (_c *MockHTTPClient_Do_Call) Run(run func(req *http.Request)) *MockHTTPClient_Do_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		req, _ := args[0].(*http.Request)
+		run(req)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Do call
+func (_c *MockHTTPClient_Do_Call) Return(_a0 *http.Response, _a1 error) *MockHTTPClient_Do_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
@@ -0,0 +1,204 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Instance = (*MockInstance)(nil)
+
+// MockInstance is an autogenerated mock type for the Instance type
+type MockInstance struct {
+	mock.Mock
+}
+
+// Metadata provides a mock function with given fields: ctx, reqOpts
+func (_m *MockInstance) Metadata(ctx context.Context, reqOpts ...RequestOption) (Metadata, error) {
+	_ca := []interface{}{ctx}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Metadata
+	if rf, ok := ret.Get(0).(func(context.Context, ...RequestOption) Metadata); ok {
+		r0 = rf(ctx, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Metadata)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...RequestOption) error); ok {
+		r1 = rf(ctx, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SupportsFeature provides a mock function with given fields: ctx, feature, reqOpts
+func (_m *MockInstance) SupportsFeature(ctx context.Context, feature Feature, reqOpts ...RequestOption) (bool, error) {
+	_ca := []interface{}{ctx, feature}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, Feature, ...RequestOption) bool); ok {
+		r0 = rf(ctx, feature, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, Feature, ...RequestOption) error); ok {
+		r1 = rf(ctx, feature, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Version provides a mock function with given fields: ctx, reqOpts
+func (_m *MockInstance) Version(ctx context.Context, reqOpts ...RequestOption) (Version, error) {
+	_ca := []interface{}{ctx}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Version
+	if rf, ok := ret.Get(0).(func(context.Context, ...RequestOption) Version); ok {
+		r0 = rf(ctx, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Version)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...RequestOption) error); ok {
+		r1 = rf(ctx, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInstance_Expecter provides typed helpers to set expectations
+type MockInstance_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockInstance) EXPECT() *MockInstance_Expecter {
+	return &MockInstance_Expecter{mock: &_m.Mock}
+}
+
+// MockInstance_Metadata_Call is an expectation of Metadata call
+type MockInstance_Metadata_Call struct {
+	*mock.Call
+}
+
+// Metadata sets expectation of Metadata call, arguments are values or argument matchers
+func (_e *MockInstance_Expecter) Metadata(ctx interface{}, reqOpts ...interface{}) *MockInstance_Metadata_Call {
+	_ca := append([]interface{}{ctx}, reqOpts...)
+	return &MockInstance_Metadata_Call{Call: _e.mock.On("Metadata", _ca...)}
+}
+
+// Run sets function called with arguments of Metadata call
+func (_c *MockInstance_Metadata_Call) Run(run func(ctx context.Context, reqOpts ...RequestOption)) *MockInstance_Metadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		reqOpts := make([]RequestOption, 0, len(args)-1)
+		for _, _a := range args[1:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Metadata call
+func (_c *MockInstance_Metadata_Call) Return(_a0 Metadata, _a1 error) *MockInstance_Metadata_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockInstance_SupportsFeature_Call is an expectation of SupportsFeature call
+type MockInstance_SupportsFeature_Call struct {
+	*mock.Call
+}
+
+// SupportsFeature sets expectation of SupportsFeature call, arguments are values or argument matchers
+func (_e *MockInstance_Expecter) SupportsFeature(ctx interface{}, feature interface{}, reqOpts ...interface{}) *MockInstance_SupportsFeature_Call {
+	_ca := append([]interface{}{ctx, feature}, reqOpts...)
+	return &MockInstance_SupportsFeature_Call{Call: _e.mock.On("SupportsFeature", _ca...)}
+}
+
+// Run sets function called with arguments of SupportsFeature call
+func (_c *MockInstance_SupportsFeature_Call) Run(run func(ctx context.Context, feature Feature, reqOpts ...RequestOption)) *MockInstance_SupportsFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		feature, _ := args[1].(Feature)
+		reqOpts := make([]RequestOption, 0, len(args)-2)
+		for _, _a := range args[2:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, feature, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by SupportsFeature call
+func (_c *MockInstance_SupportsFeature_Call) Return(_a0 bool, _a1 error) *MockInstance_SupportsFeature_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockInstance_Version_Call is an expectation of Version call
+type MockInstance_Version_Call struct {
+	*mock.Call
+}
+
+// Version sets expectation of Version call, arguments are values or argument matchers
+func (_e *MockInstance_Expecter) Version(ctx interface{}, reqOpts ...interface{}) *MockInstance_Version_Call {
+	_ca := append([]interface{}{ctx}, reqOpts...)
+	return &MockInstance_Version_Call{Call: _e.mock.On("Version", _ca...)}
+}
+
+// Run sets function called with arguments of Version call
+func (_c *MockInstance_Version_Call) Run(run func(ctx context.Context, reqOpts ...RequestOption)) *MockInstance_Version_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		reqOpts := make([]RequestOption, 0, len(args)-1)
+		for _, _a := range args[1:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Version call
+func (_c *MockInstance_Version_Call) Return(_a0 Version, _a1 error) *MockInstance_Version_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
@@ -0,0 +1,1192 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ MergeRequests = (*MockMergeRequests)(nil)
+
+// MockMergeRequests is an autogenerated mock type for the MergeRequests type
+type MockMergeRequests struct {
+	mock.Mock
+}
+
+// Approve provides a mock function with given fields: ctx, projectID, mrID, sha, reqOpts
+func (_m *MockMergeRequests) Approve(ctx context.Context, projectID int, mrID int, sha string, reqOpts ...RequestOption) (MergeRequestApprovals, error) {
+	_ca := []interface{}{ctx, projectID, mrID, sha}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 MergeRequestApprovals
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string, ...RequestOption) MergeRequestApprovals); ok {
+		r0 = rf(ctx, projectID, mrID, sha, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(MergeRequestApprovals)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, string, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, sha, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BuildImagePosition provides a mock function with given fields: ctx, projectID, mrID, opts, reqOpts
+func (_m *MockMergeRequests) BuildImagePosition(ctx context.Context, projectID int, mrID int, opts BuildImagePositionOptions, reqOpts ...RequestOption) (Position, error) {
+	_ca := []interface{}{ctx, projectID, mrID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Position
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, BuildImagePositionOptions, ...RequestOption) Position); ok {
+		r0 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Position)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, BuildImagePositionOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BuildPosition provides a mock function with given fields: ctx, projectID, mrID, opts, reqOpts
+func (_m *MockMergeRequests) BuildPosition(ctx context.Context, projectID int, mrID int, opts BuildPositionOptions, reqOpts ...RequestOption) (Position, error) {
+	_ca := []interface{}{ctx, projectID, mrID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Position
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, BuildPositionOptions, ...RequestOption) Position); ok {
+		r0 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Position)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, BuildPositionOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockMergeRequests) Create(ctx context.Context, projectID int, opts CreateMergeRequestOptions, reqOpts ...RequestOption) (MergeRequest, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 MergeRequest
+	if rf, ok := ret.Get(0).(func(context.Context, int, CreateMergeRequestOptions, ...RequestOption) MergeRequest); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(MergeRequest)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, CreateMergeRequestOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateApprovalRule provides a mock function with given fields: ctx, projectID, mrID, opts, reqOpts
+func (_m *MockMergeRequests) CreateApprovalRule(ctx context.Context, projectID int, mrID int, opts ApprovalRuleOptions, reqOpts ...RequestOption) (ApprovalRule, error) {
+	_ca := []interface{}{ctx, projectID, mrID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 ApprovalRule
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ApprovalRuleOptions, ...RequestOption) ApprovalRule); ok {
+		r0 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(ApprovalRule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ApprovalRuleOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteApprovalRule provides a mock function with given fields: ctx, projectID, mrID, ruleID, reqOpts
+func (_m *MockMergeRequests) DeleteApprovalRule(ctx context.Context, projectID int, mrID int, ruleID int, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, projectID, mrID, ruleID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int, ...RequestOption) error); ok {
+		r0 = rf(ctx, projectID, mrID, ruleID, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, projectID, mrID, reqOpts
+func (_m *MockMergeRequests) Get(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption) (MergeRequest, error) {
+	_ca := []interface{}{ctx, projectID, mrID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 MergeRequest
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) MergeRequest); ok {
+		r0 = rf(ctx, projectID, mrID, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(MergeRequest)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetApprovalState provides a mock function with given fields: ctx, projectID, mrID, reqOpts
+func (_m *MockMergeRequests) GetApprovalState(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption) (ApprovalState, error) {
+	_ca := []interface{}{ctx, projectID, mrID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 ApprovalState
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) ApprovalState); ok {
+		r0 = rf(ctx, projectID, mrID, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(ApprovalState)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetApprovals provides a mock function with given fields: ctx, projectID, mrID, reqOpts
+func (_m *MockMergeRequests) GetApprovals(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption) (MergeRequestApprovals, error) {
+	_ca := []interface{}{ctx, projectID, mrID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 MergeRequestApprovals
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) MergeRequestApprovals); ok {
+		r0 = rf(ctx, projectID, mrID, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(MergeRequestApprovals)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChanges provides a mock function with given fields: ctx, projectID, mrID, reqOpts
+func (_m *MockMergeRequests) GetChanges(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption) (MergeRequestChanges, error) {
+	_ca := []interface{}{ctx, projectID, mrID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 MergeRequestChanges
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) MergeRequestChanges); ok {
+		r0 = rf(ctx, projectID, mrID, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(MergeRequestChanges)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDiffVersion provides a mock function with given fields: ctx, projectID, mrID, versionID, reqOpts
+func (_m *MockMergeRequests) GetDiffVersion(ctx context.Context, projectID int, mrID int, versionID int, reqOpts ...RequestOption) (MergeRequestDiffVersion, error) {
+	_ca := []interface{}{ctx, projectID, mrID, versionID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 MergeRequestDiffVersion
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int, ...RequestOption) MergeRequestDiffVersion); ok {
+		r0 = rf(ctx, projectID, mrID, versionID, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(MergeRequestDiffVersion)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, versionID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetParticipants provides a mock function with given fields: ctx, projectID, mrID, opts, reqOpts
+func (_m *MockMergeRequests) GetParticipants(ctx context.Context, projectID int, mrID int, opts ParticipantsReportOptions, reqOpts ...RequestOption) ([]Participant, error) {
+	_ca := []interface{}{ctx, projectID, mrID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []Participant
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ParticipantsReportOptions, ...RequestOption) []Participant); ok {
+		r0 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Participant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ParticipantsReportOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockMergeRequests) List(ctx context.Context, projectID int, opts ListMergeRequestsOptions, reqOpts ...RequestOption) ([]MergeRequest, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []MergeRequest
+	if rf, ok := ret.Get(0).(func(context.Context, int, ListMergeRequestsOptions, ...RequestOption) []MergeRequest); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]MergeRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ListMergeRequestsOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListApprovalRules provides a mock function with given fields: ctx, projectID, mrID, reqOpts
+func (_m *MockMergeRequests) ListApprovalRules(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption) ([]ApprovalRule, error) {
+	_ca := []interface{}{ctx, projectID, mrID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []ApprovalRule
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) []ApprovalRule); ok {
+		r0 = rf(ctx, projectID, mrID, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ApprovalRule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDiffVersions provides a mock function with given fields: ctx, projectID, mrID, reqOpts
+func (_m *MockMergeRequests) ListDiffVersions(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption) ([]MergeRequestDiffVersion, error) {
+	_ca := []interface{}{ctx, projectID, mrID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []MergeRequestDiffVersion
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) []MergeRequestDiffVersion); ok {
+		r0 = rf(ctx, projectID, mrID, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]MergeRequestDiffVersion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDiffs provides a mock function with given fields: ctx, projectID, mrID, opts, reqOpts
+func (_m *MockMergeRequests) ListDiffs(ctx context.Context, projectID int, mrID int, opts ListOptions, reqOpts ...RequestOption) ([]Diff, error) {
+	_ca := []interface{}{ctx, projectID, mrID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []Diff
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ListOptions, ...RequestOption) []Diff); ok {
+		r0 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Diff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ListOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unapprove provides a mock function with given fields: ctx, projectID, mrID, reqOpts
+func (_m *MockMergeRequests) Unapprove(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, projectID, mrID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) error); ok {
+		r0 = rf(ctx, projectID, mrID, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, projectID, mrID, opts, reqOpts
+func (_m *MockMergeRequests) Update(ctx context.Context, projectID int, mrID int, opts UpdateMergeRequestOptions, reqOpts ...RequestOption) (MergeRequest, error) {
+	_ca := []interface{}{ctx, projectID, mrID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 MergeRequest
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, UpdateMergeRequestOptions, ...RequestOption) MergeRequest); ok {
+		r0 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(MergeRequest)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, UpdateMergeRequestOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateApprovalRule provides a mock function with given fields: ctx, projectID, mrID, ruleID, opts, reqOpts
+func (_m *MockMergeRequests) UpdateApprovalRule(ctx context.Context, projectID int, mrID int, ruleID int, opts UpdateApprovalRuleOptions, reqOpts ...RequestOption) (ApprovalRule, error) {
+	_ca := []interface{}{ctx, projectID, mrID, ruleID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 ApprovalRule
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int, UpdateApprovalRuleOptions, ...RequestOption) ApprovalRule); ok {
+		r0 = rf(ctx, projectID, mrID, ruleID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(ApprovalRule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int, UpdateApprovalRuleOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, mrID, ruleID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMergeRequests_Expecter provides typed helpers to set expectations
+type MockMergeRequests_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockMergeRequests) EXPECT() *MockMergeRequests_Expecter {
+	return &MockMergeRequests_Expecter{mock: &_m.Mock}
+}
+
+// MockMergeRequests_Approve_Call is an expectation of Approve call
+type MockMergeRequests_Approve_Call struct {
+	*mock.Call
+}
+
+// Approve sets expectation of Approve call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) Approve(ctx interface{}, projectID interface{}, mrID interface{}, sha interface{}, reqOpts ...interface{}) *MockMergeRequests_Approve_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, sha}, reqOpts...)
+	return &MockMergeRequests_Approve_Call{Call: _e.mock.On("Approve", _ca...)}
+}
+
+// Run sets function called with arguments of Approve call
+func (_c *MockMergeRequests_Approve_Call) Run(run func(ctx context.Context, projectID int, mrID int, sha string, reqOpts ...RequestOption)) *MockMergeRequests_Approve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		sha, _ := args[3].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, sha, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Approve call
+func (_c *MockMergeRequests_Approve_Call) Return(_a0 MergeRequestApprovals, _a1 error) *MockMergeRequests_Approve_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_BuildImagePosition_Call is an expectation of BuildImagePosition call
+type MockMergeRequests_BuildImagePosition_Call struct {
+	*mock.Call
+}
+
+// BuildImagePosition sets expectation of BuildImagePosition call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) BuildImagePosition(ctx interface{}, projectID interface{}, mrID interface{}, opts interface{}, reqOpts ...interface{}) *MockMergeRequests_BuildImagePosition_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, opts}, reqOpts...)
+	return &MockMergeRequests_BuildImagePosition_Call{Call: _e.mock.On("BuildImagePosition", _ca...)}
+}
+
+// Run sets function called with arguments of BuildImagePosition call
+func (_c *MockMergeRequests_BuildImagePosition_Call) Run(run func(ctx context.Context, projectID int, mrID int, opts BuildImagePositionOptions, reqOpts ...RequestOption)) *MockMergeRequests_BuildImagePosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		opts, _ := args[3].(BuildImagePositionOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by BuildImagePosition call
+func (_c *MockMergeRequests_BuildImagePosition_Call) Return(_a0 Position, _a1 error) *MockMergeRequests_BuildImagePosition_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_BuildPosition_Call is an expectation of BuildPosition call
+type MockMergeRequests_BuildPosition_Call struct {
+	*mock.Call
+}
+
+// BuildPosition sets expectation of BuildPosition call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) BuildPosition(ctx interface{}, projectID interface{}, mrID interface{}, opts interface{}, reqOpts ...interface{}) *MockMergeRequests_BuildPosition_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, opts}, reqOpts...)
+	return &MockMergeRequests_BuildPosition_Call{Call: _e.mock.On("BuildPosition", _ca...)}
+}
+
+// Run sets function called with arguments of BuildPosition call
+func (_c *MockMergeRequests_BuildPosition_Call) Run(run func(ctx context.Context, projectID int, mrID int, opts BuildPositionOptions, reqOpts ...RequestOption)) *MockMergeRequests_BuildPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		opts, _ := args[3].(BuildPositionOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by BuildPosition call
+func (_c *MockMergeRequests_BuildPosition_Call) Return(_a0 Position, _a1 error) *MockMergeRequests_BuildPosition_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_Create_Call is an expectation of Create call
+type MockMergeRequests_Create_Call struct {
+	*mock.Call
+}
+
+// Create sets expectation of Create call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) Create(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockMergeRequests_Create_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockMergeRequests_Create_Call{Call: _e.mock.On("Create", _ca...)}
+}
+
+// Run sets function called with arguments of Create call
+func (_c *MockMergeRequests_Create_Call) Run(run func(ctx context.Context, projectID int, opts CreateMergeRequestOptions, reqOpts ...RequestOption)) *MockMergeRequests_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(CreateMergeRequestOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Create call
+func (_c *MockMergeRequests_Create_Call) Return(_a0 MergeRequest, _a1 error) *MockMergeRequests_Create_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_CreateApprovalRule_Call is an expectation of CreateApprovalRule call
+type MockMergeRequests_CreateApprovalRule_Call struct {
+	*mock.Call
+}
+
+// CreateApprovalRule sets expectation of CreateApprovalRule call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) CreateApprovalRule(ctx interface{}, projectID interface{}, mrID interface{}, opts interface{}, reqOpts ...interface{}) *MockMergeRequests_CreateApprovalRule_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, opts}, reqOpts...)
+	return &MockMergeRequests_CreateApprovalRule_Call{Call: _e.mock.On("CreateApprovalRule", _ca...)}
+}
+
+// Run sets function called with arguments of CreateApprovalRule call
+func (_c *MockMergeRequests_CreateApprovalRule_Call) Run(run func(ctx context.Context, projectID int, mrID int, opts ApprovalRuleOptions, reqOpts ...RequestOption)) *MockMergeRequests_CreateApprovalRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		opts, _ := args[3].(ApprovalRuleOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by CreateApprovalRule call
+func (_c *MockMergeRequests_CreateApprovalRule_Call) Return(_a0 ApprovalRule, _a1 error) *MockMergeRequests_CreateApprovalRule_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_DeleteApprovalRule_Call is an expectation of DeleteApprovalRule call
+type MockMergeRequests_DeleteApprovalRule_Call struct {
+	*mock.Call
+}
+
+// DeleteApprovalRule sets expectation of DeleteApprovalRule call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) DeleteApprovalRule(ctx interface{}, projectID interface{}, mrID interface{}, ruleID interface{}, reqOpts ...interface{}) *MockMergeRequests_DeleteApprovalRule_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, ruleID}, reqOpts...)
+	return &MockMergeRequests_DeleteApprovalRule_Call{Call: _e.mock.On("DeleteApprovalRule", _ca...)}
+}
+
+// Run sets function called with arguments of DeleteApprovalRule call
+func (_c *MockMergeRequests_DeleteApprovalRule_Call) Run(run func(ctx context.Context, projectID int, mrID int, ruleID int, reqOpts ...RequestOption)) *MockMergeRequests_DeleteApprovalRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		ruleID, _ := args[3].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, ruleID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by DeleteApprovalRule call
+func (_c *MockMergeRequests_DeleteApprovalRule_Call) Return(_a0 error) *MockMergeRequests_DeleteApprovalRule_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockMergeRequests_Get_Call is an expectation of Get call
+type MockMergeRequests_Get_Call struct {
+	*mock.Call
+}
+
+// Get sets expectation of Get call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) Get(ctx interface{}, projectID interface{}, mrID interface{}, reqOpts ...interface{}) *MockMergeRequests_Get_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID}, reqOpts...)
+	return &MockMergeRequests_Get_Call{Call: _e.mock.On("Get", _ca...)}
+}
+
+// Run sets function called with arguments of Get call
+func (_c *MockMergeRequests_Get_Call) Run(run func(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption)) *MockMergeRequests_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Get call
+func (_c *MockMergeRequests_Get_Call) Return(_a0 MergeRequest, _a1 error) *MockMergeRequests_Get_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_GetApprovalState_Call is an expectation of GetApprovalState call
+type MockMergeRequests_GetApprovalState_Call struct {
+	*mock.Call
+}
+
+// GetApprovalState sets expectation of GetApprovalState call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) GetApprovalState(ctx interface{}, projectID interface{}, mrID interface{}, reqOpts ...interface{}) *MockMergeRequests_GetApprovalState_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID}, reqOpts...)
+	return &MockMergeRequests_GetApprovalState_Call{Call: _e.mock.On("GetApprovalState", _ca...)}
+}
+
+// Run sets function called with arguments of GetApprovalState call
+func (_c *MockMergeRequests_GetApprovalState_Call) Run(run func(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption)) *MockMergeRequests_GetApprovalState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetApprovalState call
+func (_c *MockMergeRequests_GetApprovalState_Call) Return(_a0 ApprovalState, _a1 error) *MockMergeRequests_GetApprovalState_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_GetApprovals_Call is an expectation of GetApprovals call
+type MockMergeRequests_GetApprovals_Call struct {
+	*mock.Call
+}
+
+// GetApprovals sets expectation of GetApprovals call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) GetApprovals(ctx interface{}, projectID interface{}, mrID interface{}, reqOpts ...interface{}) *MockMergeRequests_GetApprovals_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID}, reqOpts...)
+	return &MockMergeRequests_GetApprovals_Call{Call: _e.mock.On("GetApprovals", _ca...)}
+}
+
+// Run sets function called with arguments of GetApprovals call
+func (_c *MockMergeRequests_GetApprovals_Call) Run(run func(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption)) *MockMergeRequests_GetApprovals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetApprovals call
+func (_c *MockMergeRequests_GetApprovals_Call) Return(_a0 MergeRequestApprovals, _a1 error) *MockMergeRequests_GetApprovals_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_GetChanges_Call is an expectation of GetChanges call
+type MockMergeRequests_GetChanges_Call struct {
+	*mock.Call
+}
+
+// GetChanges sets expectation of GetChanges call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) GetChanges(ctx interface{}, projectID interface{}, mrID interface{}, reqOpts ...interface{}) *MockMergeRequests_GetChanges_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID}, reqOpts...)
+	return &MockMergeRequests_GetChanges_Call{Call: _e.mock.On("GetChanges", _ca...)}
+}
+
+// Run sets function called with arguments of GetChanges call
+func (_c *MockMergeRequests_GetChanges_Call) Run(run func(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption)) *MockMergeRequests_GetChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetChanges call
+func (_c *MockMergeRequests_GetChanges_Call) Return(_a0 MergeRequestChanges, _a1 error) *MockMergeRequests_GetChanges_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_GetDiffVersion_Call is an expectation of GetDiffVersion call
+type MockMergeRequests_GetDiffVersion_Call struct {
+	*mock.Call
+}
+
+// GetDiffVersion sets expectation of GetDiffVersion call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) GetDiffVersion(ctx interface{}, projectID interface{}, mrID interface{}, versionID interface{}, reqOpts ...interface{}) *MockMergeRequests_GetDiffVersion_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, versionID}, reqOpts...)
+	return &MockMergeRequests_GetDiffVersion_Call{Call: _e.mock.On("GetDiffVersion", _ca...)}
+}
+
+// Run sets function called with arguments of GetDiffVersion call
+func (_c *MockMergeRequests_GetDiffVersion_Call) Run(run func(ctx context.Context, projectID int, mrID int, versionID int, reqOpts ...RequestOption)) *MockMergeRequests_GetDiffVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		versionID, _ := args[3].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, versionID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetDiffVersion call
+func (_c *MockMergeRequests_GetDiffVersion_Call) Return(_a0 MergeRequestDiffVersion, _a1 error) *MockMergeRequests_GetDiffVersion_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_GetParticipants_Call is an expectation of GetParticipants call
+type MockMergeRequests_GetParticipants_Call struct {
+	*mock.Call
+}
+
+// GetParticipants sets expectation of GetParticipants call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) GetParticipants(ctx interface{}, projectID interface{}, mrID interface{}, opts interface{}, reqOpts ...interface{}) *MockMergeRequests_GetParticipants_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, opts}, reqOpts...)
+	return &MockMergeRequests_GetParticipants_Call{Call: _e.mock.On("GetParticipants", _ca...)}
+}
+
+// Run sets function called with arguments of GetParticipants call
+func (_c *MockMergeRequests_GetParticipants_Call) Run(run func(ctx context.Context, projectID int, mrID int, opts ParticipantsReportOptions, reqOpts ...RequestOption)) *MockMergeRequests_GetParticipants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		opts, _ := args[3].(ParticipantsReportOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetParticipants call
+func (_c *MockMergeRequests_GetParticipants_Call) Return(_a0 []Participant, _a1 error) *MockMergeRequests_GetParticipants_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_List_Call is an expectation of List call
+type MockMergeRequests_List_Call struct {
+	*mock.Call
+}
+
+// List sets expectation of List call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) List(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockMergeRequests_List_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockMergeRequests_List_Call{Call: _e.mock.On("List", _ca...)}
+}
+
+// Run sets function called with arguments of List call
+func (_c *MockMergeRequests_List_Call) Run(run func(ctx context.Context, projectID int, opts ListMergeRequestsOptions, reqOpts ...RequestOption)) *MockMergeRequests_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(ListMergeRequestsOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by List call
+func (_c *MockMergeRequests_List_Call) Return(_a0 []MergeRequest, _a1 error) *MockMergeRequests_List_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_ListApprovalRules_Call is an expectation of ListApprovalRules call
+type MockMergeRequests_ListApprovalRules_Call struct {
+	*mock.Call
+}
+
+// ListApprovalRules sets expectation of ListApprovalRules call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) ListApprovalRules(ctx interface{}, projectID interface{}, mrID interface{}, reqOpts ...interface{}) *MockMergeRequests_ListApprovalRules_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID}, reqOpts...)
+	return &MockMergeRequests_ListApprovalRules_Call{Call: _e.mock.On("ListApprovalRules", _ca...)}
+}
+
+// Run sets function called with arguments of ListApprovalRules call
+func (_c *MockMergeRequests_ListApprovalRules_Call) Run(run func(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption)) *MockMergeRequests_ListApprovalRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by ListApprovalRules call
+func (_c *MockMergeRequests_ListApprovalRules_Call) Return(_a0 []ApprovalRule, _a1 error) *MockMergeRequests_ListApprovalRules_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_ListDiffVersions_Call is an expectation of ListDiffVersions call
+type MockMergeRequests_ListDiffVersions_Call struct {
+	*mock.Call
+}
+
+// ListDiffVersions sets expectation of ListDiffVersions call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) ListDiffVersions(ctx interface{}, projectID interface{}, mrID interface{}, reqOpts ...interface{}) *MockMergeRequests_ListDiffVersions_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID}, reqOpts...)
+	return &MockMergeRequests_ListDiffVersions_Call{Call: _e.mock.On("ListDiffVersions", _ca...)}
+}
+
+// Run sets function called with arguments of ListDiffVersions call
+func (_c *MockMergeRequests_ListDiffVersions_Call) Run(run func(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption)) *MockMergeRequests_ListDiffVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by ListDiffVersions call
+func (_c *MockMergeRequests_ListDiffVersions_Call) Return(_a0 []MergeRequestDiffVersion, _a1 error) *MockMergeRequests_ListDiffVersions_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_ListDiffs_Call is an expectation of ListDiffs call
+type MockMergeRequests_ListDiffs_Call struct {
+	*mock.Call
+}
+
+// ListDiffs sets expectation of ListDiffs call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) ListDiffs(ctx interface{}, projectID interface{}, mrID interface{}, opts interface{}, reqOpts ...interface{}) *MockMergeRequests_ListDiffs_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, opts}, reqOpts...)
+	return &MockMergeRequests_ListDiffs_Call{Call: _e.mock.On("ListDiffs", _ca...)}
+}
+
+// Run sets function called with arguments of ListDiffs call
+func (_c *MockMergeRequests_ListDiffs_Call) Run(run func(ctx context.Context, projectID int, mrID int, opts ListOptions, reqOpts ...RequestOption)) *MockMergeRequests_ListDiffs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		opts, _ := args[3].(ListOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by ListDiffs call
+func (_c *MockMergeRequests_ListDiffs_Call) Return(_a0 []Diff, _a1 error) *MockMergeRequests_ListDiffs_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_Unapprove_Call is an expectation of Unapprove call
+type MockMergeRequests_Unapprove_Call struct {
+	*mock.Call
+}
+
+// Unapprove sets expectation of Unapprove call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) Unapprove(ctx interface{}, projectID interface{}, mrID interface{}, reqOpts ...interface{}) *MockMergeRequests_Unapprove_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID}, reqOpts...)
+	return &MockMergeRequests_Unapprove_Call{Call: _e.mock.On("Unapprove", _ca...)}
+}
+
+// Run sets function called with arguments of Unapprove call
+func (_c *MockMergeRequests_Unapprove_Call) Run(run func(ctx context.Context, projectID int, mrID int, reqOpts ...RequestOption)) *MockMergeRequests_Unapprove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Unapprove call
+func (_c *MockMergeRequests_Unapprove_Call) Return(_a0 error) *MockMergeRequests_Unapprove_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockMergeRequests_Update_Call is an expectation of Update call
+type MockMergeRequests_Update_Call struct {
+	*mock.Call
+}
+
+// Update sets expectation of Update call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) Update(ctx interface{}, projectID interface{}, mrID interface{}, opts interface{}, reqOpts ...interface{}) *MockMergeRequests_Update_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, opts}, reqOpts...)
+	return &MockMergeRequests_Update_Call{Call: _e.mock.On("Update", _ca...)}
+}
+
+// Run sets function called with arguments of Update call
+func (_c *MockMergeRequests_Update_Call) Run(run func(ctx context.Context, projectID int, mrID int, opts UpdateMergeRequestOptions, reqOpts ...RequestOption)) *MockMergeRequests_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		opts, _ := args[3].(UpdateMergeRequestOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Update call
+func (_c *MockMergeRequests_Update_Call) Return(_a0 MergeRequest, _a1 error) *MockMergeRequests_Update_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockMergeRequests_UpdateApprovalRule_Call is an expectation of UpdateApprovalRule call
+type MockMergeRequests_UpdateApprovalRule_Call struct {
+	*mock.Call
+}
+
+// UpdateApprovalRule sets expectation of UpdateApprovalRule call, arguments are values or argument matchers
+func (_e *MockMergeRequests_Expecter) UpdateApprovalRule(ctx interface{}, projectID interface{}, mrID interface{}, ruleID interface{}, opts interface{}, reqOpts ...interface{}) *MockMergeRequests_UpdateApprovalRule_Call {
+	_ca := append([]interface{}{ctx, projectID, mrID, ruleID, opts}, reqOpts...)
+	return &MockMergeRequests_UpdateApprovalRule_Call{Call: _e.mock.On("UpdateApprovalRule", _ca...)}
+}
+
+// Run sets function called with arguments of UpdateApprovalRule call
+func (_c *MockMergeRequests_UpdateApprovalRule_Call) Run(run func(ctx context.Context, projectID int, mrID int, ruleID int, opts UpdateApprovalRuleOptions, reqOpts ...RequestOption)) *MockMergeRequests_UpdateApprovalRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		mrID, _ := args[2].(int)
+		ruleID, _ := args[3].(int)
+		opts, _ := args[4].(UpdateApprovalRuleOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-5)
+		for _, _a := range args[5:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, mrID, ruleID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by UpdateApprovalRule call
+func (_c *MockMergeRequests_UpdateApprovalRule_Call) Return(_a0 ApprovalRule, _a1 error) *MockMergeRequests_UpdateApprovalRule_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
@@ -0,0 +1,732 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Projects = (*MockProjects)(nil)
+
+// MockProjects is an autogenerated mock type for the Projects type
+type MockProjects struct {
+	mock.Mock
+}
+
+// AddHook provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockProjects) AddHook(ctx context.Context, projectID int, opts HookOptions, reqOpts ...RequestOption) (Hook, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Hook
+	if rf, ok := ret.Get(0).(func(context.Context, int, HookOptions, ...RequestOption) Hook); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Hook)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, HookOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateApprovalRule provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockProjects) CreateApprovalRule(ctx context.Context, projectID int, opts ApprovalRuleOptions, reqOpts ...RequestOption) (ApprovalRule, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 ApprovalRule
+	if rf, ok := ret.Get(0).(func(context.Context, int, ApprovalRuleOptions, ...RequestOption) ApprovalRule); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(ApprovalRule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ApprovalRuleOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteApprovalRule provides a mock function with given fields: ctx, projectID, ruleID, reqOpts
+func (_m *MockProjects) DeleteApprovalRule(ctx context.Context, projectID int, ruleID int, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, projectID, ruleID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) error); ok {
+		r0 = rf(ctx, projectID, ruleID, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteHook provides a mock function with given fields: ctx, projectID, hookID, reqOpts
+func (_m *MockProjects) DeleteHook(ctx context.Context, projectID int, hookID int, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, projectID, hookID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) error); ok {
+		r0 = rf(ctx, projectID, hookID, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteHookUrlVariable provides a mock function with given fields: ctx, projectID, hookID, key, reqOpts
+func (_m *MockProjects) DeleteHookUrlVariable(ctx context.Context, projectID int, hookID int, key string, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, projectID, hookID, key}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string, ...RequestOption) error); ok {
+		r0 = rf(ctx, projectID, hookID, key, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EditHook provides a mock function with given fields: ctx, projectID, hookID, opts, reqOpts
+func (_m *MockProjects) EditHook(ctx context.Context, projectID int, hookID int, opts HookOptions, reqOpts ...RequestOption) (Hook, error) {
+	_ca := []interface{}{ctx, projectID, hookID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Hook
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, HookOptions, ...RequestOption) Hook); ok {
+		r0 = rf(ctx, projectID, hookID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Hook)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, HookOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, hookID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHook provides a mock function with given fields: ctx, projectID, hookID, reqOpts
+func (_m *MockProjects) GetHook(ctx context.Context, projectID int, hookID int, reqOpts ...RequestOption) (Hook, error) {
+	_ca := []interface{}{ctx, projectID, hookID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Hook
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) Hook); ok {
+		r0 = rf(ctx, projectID, hookID, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Hook)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, hookID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListApprovalRules provides a mock function with given fields: ctx, projectID, reqOpts
+func (_m *MockProjects) ListApprovalRules(ctx context.Context, projectID int, reqOpts ...RequestOption) ([]ApprovalRule, error) {
+	_ca := []interface{}{ctx, projectID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []ApprovalRule
+	if rf, ok := ret.Get(0).(func(context.Context, int, ...RequestOption) []ApprovalRule); ok {
+		r0 = rf(ctx, projectID, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ApprovalRule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListHooks provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockProjects) ListHooks(ctx context.Context, projectID int, opts ListOptions, reqOpts ...RequestOption) ([]Hook, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []Hook
+	if rf, ok := ret.Get(0).(func(context.Context, int, ListOptions, ...RequestOption) []Hook); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Hook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ListOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetHookUrlVariable provides a mock function with given fields: ctx, projectID, hookID, key, value, reqOpts
+func (_m *MockProjects) SetHookUrlVariable(ctx context.Context, projectID int, hookID int, key string, value string, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, projectID, hookID, key, value}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string, string, ...RequestOption) error); ok {
+		r0 = rf(ctx, projectID, hookID, key, value, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TestHook provides a mock function with given fields: ctx, projectID, hookID, trigger, reqOpts
+func (_m *MockProjects) TestHook(ctx context.Context, projectID int, hookID int, trigger HookTrigger, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, projectID, hookID, trigger}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, HookTrigger, ...RequestOption) error); ok {
+		r0 = rf(ctx, projectID, hookID, trigger, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateApprovalRule provides a mock function with given fields: ctx, projectID, ruleID, opts, reqOpts
+func (_m *MockProjects) UpdateApprovalRule(ctx context.Context, projectID int, ruleID int, opts UpdateApprovalRuleOptions, reqOpts ...RequestOption) (ApprovalRule, error) {
+	_ca := []interface{}{ctx, projectID, ruleID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 ApprovalRule
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, UpdateApprovalRuleOptions, ...RequestOption) ApprovalRule); ok {
+		r0 = rf(ctx, projectID, ruleID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(ApprovalRule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, UpdateApprovalRuleOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, ruleID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjects_Expecter provides typed helpers to set expectations
+type MockProjects_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockProjects) EXPECT() *MockProjects_Expecter {
+	return &MockProjects_Expecter{mock: &_m.Mock}
+}
+
+// MockProjects_AddHook_Call is an expectation of AddHook call
+type MockProjects_AddHook_Call struct {
+	*mock.Call
+}
+
+// AddHook sets expectation of AddHook call, arguments are values or argument matchers
+func (_e *MockProjects_Expecter) AddHook(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockProjects_AddHook_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockProjects_AddHook_Call{Call: _e.mock.On("AddHook", _ca...)}
+}
+
+// Run sets function called with arguments of AddHook call
+func (_c *MockProjects_AddHook_Call) Run(run func(ctx context.Context, projectID int, opts HookOptions, reqOpts ...RequestOption)) *MockProjects_AddHook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(HookOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by AddHook call
+func (_c *MockProjects_AddHook_Call) Return(_a0 Hook, _a1 error) *MockProjects_AddHook_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockProjects_CreateApprovalRule_Call is an expectation of CreateApprovalRule call
+type MockProjects_CreateApprovalRule_Call struct {
+	*mock.Call
+}
+
+// CreateApprovalRule sets expectation of CreateApprovalRule call, arguments are values or argument matchers
+func (_e *MockProjects_Expecter) CreateApprovalRule(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockProjects_CreateApprovalRule_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockProjects_CreateApprovalRule_Call{Call: _e.mock.On("CreateApprovalRule", _ca...)}
+}
+
+// Run sets function called with arguments of CreateApprovalRule call
+func (_c *MockProjects_CreateApprovalRule_Call) Run(run func(ctx context.Context, projectID int, opts ApprovalRuleOptions, reqOpts ...RequestOption)) *MockProjects_CreateApprovalRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(ApprovalRuleOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by CreateApprovalRule call
+func (_c *MockProjects_CreateApprovalRule_Call) Return(_a0 ApprovalRule, _a1 error) *MockProjects_CreateApprovalRule_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockProjects_DeleteApprovalRule_Call is an expectation of DeleteApprovalRule call
+type MockProjects_DeleteApprovalRule_Call struct {
+	*mock.Call
+}
+
+// DeleteApprovalRule sets expectation of DeleteApprovalRule call, arguments are values or argument matchers
+func (_e *MockProjects_Expecter) DeleteApprovalRule(ctx interface{}, projectID interface{}, ruleID interface{}, reqOpts ...interface{}) *MockProjects_DeleteApprovalRule_Call {
+	_ca := append([]interface{}{ctx, projectID, ruleID}, reqOpts...)
+	return &MockProjects_DeleteApprovalRule_Call{Call: _e.mock.On("DeleteApprovalRule", _ca...)}
+}
+
+// Run sets function called with arguments of DeleteApprovalRule call
+func (_c *MockProjects_DeleteApprovalRule_Call) Run(run func(ctx context.Context, projectID int, ruleID int, reqOpts ...RequestOption)) *MockProjects_DeleteApprovalRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		ruleID, _ := args[2].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, ruleID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by DeleteApprovalRule call
+func (_c *MockProjects_DeleteApprovalRule_Call) Return(_a0 error) *MockProjects_DeleteApprovalRule_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockProjects_DeleteHook_Call is an expectation of DeleteHook call
+type MockProjects_DeleteHook_Call struct {
+	*mock.Call
+}
+
+// DeleteHook sets expectation of DeleteHook call, arguments are values or argument matchers
+func (_e *MockProjects_Expecter) DeleteHook(ctx interface{}, projectID interface{}, hookID interface{}, reqOpts ...interface{}) *MockProjects_DeleteHook_Call {
+	_ca := append([]interface{}{ctx, projectID, hookID}, reqOpts...)
+	return &MockProjects_DeleteHook_Call{Call: _e.mock.On("DeleteHook", _ca...)}
+}
+
+// Run sets function called with arguments of DeleteHook call
+func (_c *MockProjects_DeleteHook_Call) Run(run func(ctx context.Context, projectID int, hookID int, reqOpts ...RequestOption)) *MockProjects_DeleteHook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		hookID, _ := args[2].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, hookID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by DeleteHook call
+func (_c *MockProjects_DeleteHook_Call) Return(_a0 error) *MockProjects_DeleteHook_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockProjects_DeleteHookUrlVariable_Call is an expectation of DeleteHookUrlVariable call
+type MockProjects_DeleteHookUrlVariable_Call struct {
+	*mock.Call
+}
+
+// DeleteHookUrlVariable sets expectation of DeleteHookUrlVariable call, arguments are values or argument matchers
+func (_e *MockProjects_Expecter) DeleteHookUrlVariable(ctx interface{}, projectID interface{}, hookID interface{}, key interface{}, reqOpts ...interface{}) *MockProjects_DeleteHookUrlVariable_Call {
+	_ca := append([]interface{}{ctx, projectID, hookID, key}, reqOpts...)
+	return &MockProjects_DeleteHookUrlVariable_Call{Call: _e.mock.On("DeleteHookUrlVariable", _ca...)}
+}
+
+// Run sets function called with arguments of DeleteHookUrlVariable call
+func (_c *MockProjects_DeleteHookUrlVariable_Call) Run(run func(ctx context.Context, projectID int, hookID int, key string, reqOpts ...RequestOption)) *MockProjects_DeleteHookUrlVariable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		hookID, _ := args[2].(int)
+		key, _ := args[3].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, hookID, key, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by DeleteHookUrlVariable call
+func (_c *MockProjects_DeleteHookUrlVariable_Call) Return(_a0 error) *MockProjects_DeleteHookUrlVariable_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockProjects_EditHook_Call is an expectation of EditHook call
+type MockProjects_EditHook_Call struct {
+	*mock.Call
+}
+
+// EditHook sets expectation of EditHook call, arguments are values or argument matchers
+func (_e *MockProjects_Expecter) EditHook(ctx interface{}, projectID interface{}, hookID interface{}, opts interface{}, reqOpts ...interface{}) *MockProjects_EditHook_Call {
+	_ca := append([]interface{}{ctx, projectID, hookID, opts}, reqOpts...)
+	return &MockProjects_EditHook_Call{Call: _e.mock.On("EditHook", _ca...)}
+}
+
+// Run sets function called with arguments of EditHook call
+func (_c *MockProjects_EditHook_Call) Run(run func(ctx context.Context, projectID int, hookID int, opts HookOptions, reqOpts ...RequestOption)) *MockProjects_EditHook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		hookID, _ := args[2].(int)
+		opts, _ := args[3].(HookOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, hookID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by EditHook call
+func (_c *MockProjects_EditHook_Call) Return(_a0 Hook, _a1 error) *MockProjects_EditHook_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockProjects_GetHook_Call is an expectation of GetHook call
+type MockProjects_GetHook_Call struct {
+	*mock.Call
+}
+
+// GetHook sets expectation of GetHook call, arguments are values or argument matchers
+func (_e *MockProjects_Expecter) GetHook(ctx interface{}, projectID interface{}, hookID interface{}, reqOpts ...interface{}) *MockProjects_GetHook_Call {
+	_ca := append([]interface{}{ctx, projectID, hookID}, reqOpts...)
+	return &MockProjects_GetHook_Call{Call: _e.mock.On("GetHook", _ca...)}
+}
+
+// Run sets function called with arguments of GetHook call
+func (_c *MockProjects_GetHook_Call) Run(run func(ctx context.Context, projectID int, hookID int, reqOpts ...RequestOption)) *MockProjects_GetHook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		hookID, _ := args[2].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, hookID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetHook call
+func (_c *MockProjects_GetHook_Call) Return(_a0 Hook, _a1 error) *MockProjects_GetHook_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockProjects_ListApprovalRules_Call is an expectation of ListApprovalRules call
+type MockProjects_ListApprovalRules_Call struct {
+	*mock.Call
+}
+
+// ListApprovalRules sets expectation of ListApprovalRules call, arguments are values or argument matchers
+func (_e *MockProjects_Expecter) ListApprovalRules(ctx interface{}, projectID interface{}, reqOpts ...interface{}) *MockProjects_ListApprovalRules_Call {
+	_ca := append([]interface{}{ctx, projectID}, reqOpts...)
+	return &MockProjects_ListApprovalRules_Call{Call: _e.mock.On("ListApprovalRules", _ca...)}
+}
+
+// Run sets function called with arguments of ListApprovalRules call
+func (_c *MockProjects_ListApprovalRules_Call) Run(run func(ctx context.Context, projectID int, reqOpts ...RequestOption)) *MockProjects_ListApprovalRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-2)
+		for _, _a := range args[2:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by ListApprovalRules call
+func (_c *MockProjects_ListApprovalRules_Call) Return(_a0 []ApprovalRule, _a1 error) *MockProjects_ListApprovalRules_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockProjects_ListHooks_Call is an expectation of ListHooks call
+type MockProjects_ListHooks_Call struct {
+	*mock.Call
+}
+
+// ListHooks sets expectation of ListHooks call, arguments are values or argument matchers
+func (_e *MockProjects_Expecter) ListHooks(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockProjects_ListHooks_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockProjects_ListHooks_Call{Call: _e.mock.On("ListHooks", _ca...)}
+}
+
+// Run sets function called with arguments of ListHooks call
+func (_c *MockProjects_ListHooks_Call) Run(run func(ctx context.Context, projectID int, opts ListOptions, reqOpts ...RequestOption)) *MockProjects_ListHooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(ListOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by ListHooks call
+func (_c *MockProjects_ListHooks_Call) Return(_a0 []Hook, _a1 error) *MockProjects_ListHooks_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockProjects_SetHookUrlVariable_Call is an expectation of SetHookUrlVariable call
+type MockProjects_SetHookUrlVariable_Call struct {
+	*mock.Call
+}
+
+// SetHookUrlVariable sets expectation of SetHookUrlVariable call, arguments are values or argument matchers
+func (_e *MockProjects_Expecter) SetHookUrlVariable(ctx interface{}, projectID interface{}, hookID interface{}, key interface{}, value interface{}, reqOpts ...interface{}) *MockProjects_SetHookUrlVariable_Call {
+	_ca := append([]interface{}{ctx, projectID, hookID, key, value}, reqOpts...)
+	return &MockProjects_SetHookUrlVariable_Call{Call: _e.mock.On("SetHookUrlVariable", _ca...)}
+}
+
+// Run sets function called with arguments of SetHookUrlVariable call
+func (_c *MockProjects_SetHookUrlVariable_Call) Run(run func(ctx context.Context, projectID int, hookID int, key string, value string, reqOpts ...RequestOption)) *MockProjects_SetHookUrlVariable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		hookID, _ := args[2].(int)
+		key, _ := args[3].(string)
+		value, _ := args[4].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-5)
+		for _, _a := range args[5:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, hookID, key, value, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by SetHookUrlVariable call
+func (_c *MockProjects_SetHookUrlVariable_Call) Return(_a0 error) *MockProjects_SetHookUrlVariable_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockProjects_TestHook_Call is an expectation of TestHook call
+type MockProjects_TestHook_Call struct {
+	*mock.Call
+}
+
+// TestHook sets expectation of TestHook call, arguments are values or argument matchers
+func (_e *MockProjects_Expecter) TestHook(ctx interface{}, projectID interface{}, hookID interface{}, trigger interface{}, reqOpts ...interface{}) *MockProjects_TestHook_Call {
+	_ca := append([]interface{}{ctx, projectID, hookID, trigger}, reqOpts...)
+	return &MockProjects_TestHook_Call{Call: _e.mock.On("TestHook", _ca...)}
+}
+
+// Run sets function called with arguments of TestHook call
+func (_c *MockProjects_TestHook_Call) Run(run func(ctx context.Context, projectID int, hookID int, trigger HookTrigger, reqOpts ...RequestOption)) *MockProjects_TestHook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		hookID, _ := args[2].(int)
+		trigger, _ := args[3].(HookTrigger)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, hookID, trigger, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by TestHook call
+func (_c *MockProjects_TestHook_Call) Return(_a0 error) *MockProjects_TestHook_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockProjects_UpdateApprovalRule_Call is an expectation of UpdateApprovalRule call
+type MockProjects_UpdateApprovalRule_Call struct {
+	*mock.Call
+}
+
+// UpdateApprovalRule sets expectation of UpdateApprovalRule call, arguments are values or argument matchers
+func (_e *MockProjects_Expecter) UpdateApprovalRule(ctx interface{}, projectID interface{}, ruleID interface{}, opts interface{}, reqOpts ...interface{}) *MockProjects_UpdateApprovalRule_Call {
+	_ca := append([]interface{}{ctx, projectID, ruleID, opts}, reqOpts...)
+	return &MockProjects_UpdateApprovalRule_Call{Call: _e.mock.On("UpdateApprovalRule", _ca...)}
+}
+
+// Run sets function called with arguments of UpdateApprovalRule call
+func (_c *MockProjects_UpdateApprovalRule_Call) Run(run func(ctx context.Context, projectID int, ruleID int, opts UpdateApprovalRuleOptions, reqOpts ...RequestOption)) *MockProjects_UpdateApprovalRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		ruleID, _ := args[2].(int)
+		opts, _ := args[3].(UpdateApprovalRuleOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, ruleID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by UpdateApprovalRule call
+func (_c *MockProjects_UpdateApprovalRule_Call) Return(_a0 ApprovalRule, _a1 error) *MockProjects_UpdateApprovalRule_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
@@ -0,0 +1,327 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ ProtectedBranches = (*MockProtectedBranches)(nil)
+
+// MockProtectedBranches is an autogenerated mock type for the ProtectedBranches type
+type MockProtectedBranches struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, projectID, name, reqOpts
+func (_m *MockProtectedBranches) Get(ctx context.Context, projectID int, name string, reqOpts ...RequestOption) (ProtectedBranch, error) {
+	_ca := []interface{}{ctx, projectID, name}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 ProtectedBranch
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, ...RequestOption) ProtectedBranch); ok {
+		r0 = rf(ctx, projectID, name, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(ProtectedBranch)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, name, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockProtectedBranches) List(ctx context.Context, projectID int, opts ListOptions, reqOpts ...RequestOption) ([]ProtectedBranch, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []ProtectedBranch
+	if rf, ok := ret.Get(0).(func(context.Context, int, ListOptions, ...RequestOption) []ProtectedBranch); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProtectedBranch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ListOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Protect provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockProtectedBranches) Protect(ctx context.Context, projectID int, opts ProtectBranchOptions, reqOpts ...RequestOption) (ProtectedBranch, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 ProtectedBranch
+	if rf, ok := ret.Get(0).(func(context.Context, int, ProtectBranchOptions, ...RequestOption) ProtectedBranch); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(ProtectedBranch)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ProtectBranchOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unprotect provides a mock function with given fields: ctx, projectID, name, reqOpts
+func (_m *MockProtectedBranches) Unprotect(ctx context.Context, projectID int, name string, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, projectID, name}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, ...RequestOption) error); ok {
+		r0 = rf(ctx, projectID, name, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, projectID, name, opts, reqOpts
+func (_m *MockProtectedBranches) Update(ctx context.Context, projectID int, name string, opts UpdateProtectedBranchOptions, reqOpts ...RequestOption) (ProtectedBranch, error) {
+	_ca := []interface{}{ctx, projectID, name, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 ProtectedBranch
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, UpdateProtectedBranchOptions, ...RequestOption) ProtectedBranch); ok {
+		r0 = rf(ctx, projectID, name, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(ProtectedBranch)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, UpdateProtectedBranchOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, name, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProtectedBranches_Expecter provides typed helpers to set expectations
+type MockProtectedBranches_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockProtectedBranches) EXPECT() *MockProtectedBranches_Expecter {
+	return &MockProtectedBranches_Expecter{mock: &_m.Mock}
+}
+
+// MockProtectedBranches_Get_Call is an expectation of Get call
+type MockProtectedBranches_Get_Call struct {
+	*mock.Call
+}
+
+// Get sets expectation of Get call, arguments are values or argument matchers
+func (_e *MockProtectedBranches_Expecter) Get(ctx interface{}, projectID interface{}, name interface{}, reqOpts ...interface{}) *MockProtectedBranches_Get_Call {
+	_ca := append([]interface{}{ctx, projectID, name}, reqOpts...)
+	return &MockProtectedBranches_Get_Call{Call: _e.mock.On("Get", _ca...)}
+}
+
+// Run sets function called with arguments of Get call
+func (_c *MockProtectedBranches_Get_Call) Run(run func(ctx context.Context, projectID int, name string, reqOpts ...RequestOption)) *MockProtectedBranches_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		name, _ := args[2].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, name, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Get call
+func (_c *MockProtectedBranches_Get_Call) Return(_a0 ProtectedBranch, _a1 error) *MockProtectedBranches_Get_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockProtectedBranches_List_Call is an expectation of List call
+type MockProtectedBranches_List_Call struct {
+	*mock.Call
+}
+
+// List sets expectation of List call, arguments are values or argument matchers
+func (_e *MockProtectedBranches_Expecter) List(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockProtectedBranches_List_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockProtectedBranches_List_Call{Call: _e.mock.On("List", _ca...)}
+}
+
+// Run sets function called with arguments of List call
+func (_c *MockProtectedBranches_List_Call) Run(run func(ctx context.Context, projectID int, opts ListOptions, reqOpts ...RequestOption)) *MockProtectedBranches_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(ListOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by List call
+func (_c *MockProtectedBranches_List_Call) Return(_a0 []ProtectedBranch, _a1 error) *MockProtectedBranches_List_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockProtectedBranches_Protect_Call is an expectation of Protect call
+type MockProtectedBranches_Protect_Call struct {
+	*mock.Call
+}
+
+// Protect sets expectation of Protect call, arguments are values or argument matchers
+func (_e *MockProtectedBranches_Expecter) Protect(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockProtectedBranches_Protect_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockProtectedBranches_Protect_Call{Call: _e.mock.On("Protect", _ca...)}
+}
+
+// Run sets function called with arguments of Protect call
+func (_c *MockProtectedBranches_Protect_Call) Run(run func(ctx context.Context, projectID int, opts ProtectBranchOptions, reqOpts ...RequestOption)) *MockProtectedBranches_Protect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(ProtectBranchOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Protect call
+func (_c *MockProtectedBranches_Protect_Call) Return(_a0 ProtectedBranch, _a1 error) *MockProtectedBranches_Protect_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockProtectedBranches_Unprotect_Call is an expectation of Unprotect call
+type MockProtectedBranches_Unprotect_Call struct {
+	*mock.Call
+}
+
+// Unprotect sets expectation of Unprotect call, arguments are values or argument matchers
+func (_e *MockProtectedBranches_Expecter) Unprotect(ctx interface{}, projectID interface{}, name interface{}, reqOpts ...interface{}) *MockProtectedBranches_Unprotect_Call {
+	_ca := append([]interface{}{ctx, projectID, name}, reqOpts...)
+	return &MockProtectedBranches_Unprotect_Call{Call: _e.mock.On("Unprotect", _ca...)}
+}
+
+// Run sets function called with arguments of Unprotect call
+func (_c *MockProtectedBranches_Unprotect_Call) Run(run func(ctx context.Context, projectID int, name string, reqOpts ...RequestOption)) *MockProtectedBranches_Unprotect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		name, _ := args[2].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, name, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Unprotect call
+func (_c *MockProtectedBranches_Unprotect_Call) Return(_a0 error) *MockProtectedBranches_Unprotect_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockProtectedBranches_Update_Call is an expectation of Update call
+type MockProtectedBranches_Update_Call struct {
+	*mock.Call
+}
+
+// Update sets expectation of Update call, arguments are values or argument matchers
+func (_e *MockProtectedBranches_Expecter) Update(ctx interface{}, projectID interface{}, name interface{}, opts interface{}, reqOpts ...interface{}) *MockProtectedBranches_Update_Call {
+	_ca := append([]interface{}{ctx, projectID, name, opts}, reqOpts...)
+	return &MockProtectedBranches_Update_Call{Call: _e.mock.On("Update", _ca...)}
+}
+
+// Run sets function called with arguments of Update call
+func (_c *MockProtectedBranches_Update_Call) Run(run func(ctx context.Context, projectID int, name string, opts UpdateProtectedBranchOptions, reqOpts ...RequestOption)) *MockProtectedBranches_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		name, _ := args[2].(string)
+		opts, _ := args[3].(UpdateProtectedBranchOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, name, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Update call
+func (_c *MockProtectedBranches_Update_Call) Return(_a0 ProtectedBranch, _a1 error) *MockProtectedBranches_Update_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
@@ -0,0 +1,265 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ ProtectedTags = (*MockProtectedTags)(nil)
+
+// MockProtectedTags is an autogenerated mock type for the ProtectedTags type
+type MockProtectedTags struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, projectID, name, reqOpts
+func (_m *MockProtectedTags) Get(ctx context.Context, projectID int, name string, reqOpts ...RequestOption) (ProtectedTag, error) {
+	_ca := []interface{}{ctx, projectID, name}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 ProtectedTag
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, ...RequestOption) ProtectedTag); ok {
+		r0 = rf(ctx, projectID, name, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(ProtectedTag)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, name, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockProtectedTags) List(ctx context.Context, projectID int, opts ListOptions, reqOpts ...RequestOption) ([]ProtectedTag, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []ProtectedTag
+	if rf, ok := ret.Get(0).(func(context.Context, int, ListOptions, ...RequestOption) []ProtectedTag); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProtectedTag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ListOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Protect provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockProtectedTags) Protect(ctx context.Context, projectID int, opts ProtectTagOptions, reqOpts ...RequestOption) (ProtectedTag, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 ProtectedTag
+	if rf, ok := ret.Get(0).(func(context.Context, int, ProtectTagOptions, ...RequestOption) ProtectedTag); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(ProtectedTag)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ProtectTagOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unprotect provides a mock function with given fields: ctx, projectID, name, reqOpts
+func (_m *MockProtectedTags) Unprotect(ctx context.Context, projectID int, name string, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, projectID, name}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, ...RequestOption) error); ok {
+		r0 = rf(ctx, projectID, name, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProtectedTags_Expecter provides typed helpers to set expectations
+type MockProtectedTags_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockProtectedTags) EXPECT() *MockProtectedTags_Expecter {
+	return &MockProtectedTags_Expecter{mock: &_m.Mock}
+}
+
+// MockProtectedTags_Get_Call is an expectation of Get call
+type MockProtectedTags_Get_Call struct {
+	*mock.Call
+}
+
+// Get sets expectation of Get call, arguments are values or argument matchers
+func (_e *MockProtectedTags_Expecter) Get(ctx interface{}, projectID interface{}, name interface{}, reqOpts ...interface{}) *MockProtectedTags_Get_Call {
+	_ca := append([]interface{}{ctx, projectID, name}, reqOpts...)
+	return &MockProtectedTags_Get_Call{Call: _e.mock.On("Get", _ca...)}
+}
+
+// Run sets function called with arguments of Get call
+func (_c *MockProtectedTags_Get_Call) Run(run func(ctx context.Context, projectID int, name string, reqOpts ...RequestOption)) *MockProtectedTags_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		name, _ := args[2].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, name, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Get call
+func (_c *MockProtectedTags_Get_Call) Return(_a0 ProtectedTag, _a1 error) *MockProtectedTags_Get_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockProtectedTags_List_Call is an expectation of List call
+type MockProtectedTags_List_Call struct {
+	*mock.Call
+}
+
+// List sets expectation of List call, arguments are values or argument matchers
+func (_e *MockProtectedTags_Expecter) List(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockProtectedTags_List_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockProtectedTags_List_Call{Call: _e.mock.On("List", _ca...)}
+}
+
+// Run sets function called with arguments of List call
+func (_c *MockProtectedTags_List_Call) Run(run func(ctx context.Context, projectID int, opts ListOptions, reqOpts ...RequestOption)) *MockProtectedTags_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(ListOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by List call
+func (_c *MockProtectedTags_List_Call) Return(_a0 []ProtectedTag, _a1 error) *MockProtectedTags_List_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockProtectedTags_Protect_Call is an expectation of Protect call
+type MockProtectedTags_Protect_Call struct {
+	*mock.Call
+}
+
+// Protect sets expectation of Protect call, arguments are values or argument matchers
+func (_e *MockProtectedTags_Expecter) Protect(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockProtectedTags_Protect_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockProtectedTags_Protect_Call{Call: _e.mock.On("Protect", _ca...)}
+}
+
+// Run sets function called with arguments of Protect call
+func (_c *MockProtectedTags_Protect_Call) Run(run func(ctx context.Context, projectID int, opts ProtectTagOptions, reqOpts ...RequestOption)) *MockProtectedTags_Protect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(ProtectTagOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Protect call
+func (_c *MockProtectedTags_Protect_Call) Return(_a0 ProtectedTag, _a1 error) *MockProtectedTags_Protect_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockProtectedTags_Unprotect_Call is an expectation of Unprotect call
+type MockProtectedTags_Unprotect_Call struct {
+	*mock.Call
+}
+
+// Unprotect sets expectation of Unprotect call, arguments are values or argument matchers
+func (_e *MockProtectedTags_Expecter) Unprotect(ctx interface{}, projectID interface{}, name interface{}, reqOpts ...interface{}) *MockProtectedTags_Unprotect_Call {
+	_ca := append([]interface{}{ctx, projectID, name}, reqOpts...)
+	return &MockProtectedTags_Unprotect_Call{Call: _e.mock.On("Unprotect", _ca...)}
+}
+
+// Run sets function called with arguments of Unprotect call
+func (_c *MockProtectedTags_Unprotect_Call) Run(run func(ctx context.Context, projectID int, name string, reqOpts ...RequestOption)) *MockProtectedTags_Unprotect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		name, _ := args[2].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, name, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Unprotect call
+func (_c *MockProtectedTags_Unprotect_Call) Return(_a0 error) *MockProtectedTags_Unprotect_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
@@ -0,0 +1,270 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package gitlab
+
+import (
+	"context"
+	"io"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Repository = (*MockRepository)(nil)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+// Compare provides a mock function with given fields: ctx, projectID, from, to, reqOpts
+func (_m *MockRepository) Compare(ctx context.Context, projectID int, from string, to string, reqOpts ...RequestOption) (Comparison, error) {
+	_ca := []interface{}{ctx, projectID, from, to}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Comparison
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string, ...RequestOption) Comparison); ok {
+		r0 = rf(ctx, projectID, from, to, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Comparison)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, string, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, from, to, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetArchive provides a mock function with given fields: ctx, projectID, w, opts, reqOpts
+func (_m *MockRepository) GetArchive(ctx context.Context, projectID int, w io.Writer, opts ArchiveOptions, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, projectID, w, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, io.Writer, ArchiveOptions, ...RequestOption) error); ok {
+		r0 = rf(ctx, projectID, w, opts, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListContributors provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockRepository) ListContributors(ctx context.Context, projectID int, opts ListContributorsOptions, reqOpts ...RequestOption) ([]Contributor, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []Contributor
+	if rf, ok := ret.Get(0).(func(context.Context, int, ListContributorsOptions, ...RequestOption) []Contributor); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Contributor)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ListContributorsOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTree provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockRepository) ListTree(ctx context.Context, projectID int, opts ListTreeOptions, reqOpts ...RequestOption) ([]TreeNode, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []TreeNode
+	if rf, ok := ret.Get(0).(func(context.Context, int, ListTreeOptions, ...RequestOption) []TreeNode); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TreeNode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ListTreeOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Expecter provides typed helpers to set expectations
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// MockRepository_Compare_Call is an expectation of Compare call
+type MockRepository_Compare_Call struct {
+	*mock.Call
+}
+
+// Compare sets expectation of Compare call, arguments are values or argument matchers
+func (_e *MockRepository_Expecter) Compare(ctx interface{}, projectID interface{}, from interface{}, to interface{}, reqOpts ...interface{}) *MockRepository_Compare_Call {
+	_ca := append([]interface{}{ctx, projectID, from, to}, reqOpts...)
+	return &MockRepository_Compare_Call{Call: _e.mock.On("Compare", _ca...)}
+}
+
+// Run sets function called with arguments of Compare call
+func (_c *MockRepository_Compare_Call) Run(run func(ctx context.Context, projectID int, from string, to string, reqOpts ...RequestOption)) *MockRepository_Compare_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		from, _ := args[2].(string)
+		to, _ := args[3].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, from, to, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Compare call
+func (_c *MockRepository_Compare_Call) Return(_a0 Comparison, _a1 error) *MockRepository_Compare_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockRepository_GetArchive_Call is an expectation of GetArchive call
+type MockRepository_GetArchive_Call struct {
+	*mock.Call
+}
+
+// GetArchive sets expectation of GetArchive call, arguments are values or argument matchers
+func (_e *MockRepository_Expecter) GetArchive(ctx interface{}, projectID interface{}, w interface{}, opts interface{}, reqOpts ...interface{}) *MockRepository_GetArchive_Call {
+	_ca := append([]interface{}{ctx, projectID, w, opts}, reqOpts...)
+	return &MockRepository_GetArchive_Call{Call: _e.mock.On("GetArchive", _ca...)}
+}
+
+// Run sets function called with arguments of GetArchive call
+func (_c *MockRepository_GetArchive_Call) Run(run func(ctx context.Context, projectID int, w io.Writer, opts ArchiveOptions, reqOpts ...RequestOption)) *MockRepository_GetArchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		w, _ := args[2].(io.Writer)
+		opts, _ := args[3].(ArchiveOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-4)
+		for _, _a := range args[4:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, w, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetArchive call
+func (_c *MockRepository_GetArchive_Call) Return(_a0 error) *MockRepository_GetArchive_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockRepository_ListContributors_Call is an expectation of ListContributors call
+type MockRepository_ListContributors_Call struct {
+	*mock.Call
+}
+
+// ListContributors sets expectation of ListContributors call, arguments are values or argument matchers
+func (_e *MockRepository_Expecter) ListContributors(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockRepository_ListContributors_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockRepository_ListContributors_Call{Call: _e.mock.On("ListContributors", _ca...)}
+}
+
+// Run sets function called with arguments of ListContributors call
+func (_c *MockRepository_ListContributors_Call) Run(run func(ctx context.Context, projectID int, opts ListContributorsOptions, reqOpts ...RequestOption)) *MockRepository_ListContributors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(ListContributorsOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by ListContributors call
+func (_c *MockRepository_ListContributors_Call) Return(_a0 []Contributor, _a1 error) *MockRepository_ListContributors_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockRepository_ListTree_Call is an expectation of ListTree call
+type MockRepository_ListTree_Call struct {
+	*mock.Call
+}
+
+// ListTree sets expectation of ListTree call, arguments are values or argument matchers
+func (_e *MockRepository_Expecter) ListTree(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockRepository_ListTree_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockRepository_ListTree_Call{Call: _e.mock.On("ListTree", _ca...)}
+}
+
+// Run sets function called with arguments of ListTree call
+func (_c *MockRepository_ListTree_Call) Run(run func(ctx context.Context, projectID int, opts ListTreeOptions, reqOpts ...RequestOption)) *MockRepository_ListTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(ListTreeOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by ListTree call
+func (_c *MockRepository_ListTree_Call) Return(_a0 []TreeNode, _a1 error) *MockRepository_ListTree_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
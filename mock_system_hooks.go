@@ -0,0 +1,254 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ SystemHooks = (*MockSystemHooks)(nil)
+
+// MockSystemHooks is an autogenerated mock type for the SystemHooks type
+type MockSystemHooks struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: ctx, opts, reqOpts
+func (_m *MockSystemHooks) Add(ctx context.Context, opts SystemHookOptions, reqOpts ...RequestOption) (SystemHook, error) {
+	_ca := []interface{}{ctx, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 SystemHook
+	if rf, ok := ret.Get(0).(func(context.Context, SystemHookOptions, ...RequestOption) SystemHook); ok {
+		r0 = rf(ctx, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(SystemHook)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, SystemHookOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, hookID, reqOpts
+func (_m *MockSystemHooks) Delete(ctx context.Context, hookID int, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, hookID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, ...RequestOption) error); ok {
+		r0 = rf(ctx, hookID, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx, opts, reqOpts
+func (_m *MockSystemHooks) List(ctx context.Context, opts ListOptions, reqOpts ...RequestOption) ([]SystemHook, error) {
+	_ca := []interface{}{ctx, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []SystemHook
+	if rf, ok := ret.Get(0).(func(context.Context, ListOptions, ...RequestOption) []SystemHook); ok {
+		r0 = rf(ctx, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]SystemHook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ListOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Test provides a mock function with given fields: ctx, hookID, reqOpts
+func (_m *MockSystemHooks) Test(ctx context.Context, hookID int, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, hookID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, ...RequestOption) error); ok {
+		r0 = rf(ctx, hookID, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSystemHooks_Expecter provides typed helpers to set expectations
+type MockSystemHooks_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockSystemHooks) EXPECT() *MockSystemHooks_Expecter {
+	return &MockSystemHooks_Expecter{mock: &_m.Mock}
+}
+
+// MockSystemHooks_Add_Call is an expectation of Add call
+type MockSystemHooks_Add_Call struct {
+	*mock.Call
+}
+
+// Add sets expectation of Add call, arguments are values or argument matchers
+func (_e *MockSystemHooks_Expecter) Add(ctx interface{}, opts interface{}, reqOpts ...interface{}) *MockSystemHooks_Add_Call {
+	_ca := append([]interface{}{ctx, opts}, reqOpts...)
+	return &MockSystemHooks_Add_Call{Call: _e.mock.On("Add", _ca...)}
+}
+
+// Run sets function called with arguments of Add call
+func (_c *MockSystemHooks_Add_Call) Run(run func(ctx context.Context, opts SystemHookOptions, reqOpts ...RequestOption)) *MockSystemHooks_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		opts, _ := args[1].(SystemHookOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-2)
+		for _, _a := range args[2:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Add call
+func (_c *MockSystemHooks_Add_Call) Return(_a0 SystemHook, _a1 error) *MockSystemHooks_Add_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockSystemHooks_Delete_Call is an expectation of Delete call
+type MockSystemHooks_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete sets expectation of Delete call, arguments are values or argument matchers
+func (_e *MockSystemHooks_Expecter) Delete(ctx interface{}, hookID interface{}, reqOpts ...interface{}) *MockSystemHooks_Delete_Call {
+	_ca := append([]interface{}{ctx, hookID}, reqOpts...)
+	return &MockSystemHooks_Delete_Call{Call: _e.mock.On("Delete", _ca...)}
+}
+
+// Run sets function called with arguments of Delete call
+func (_c *MockSystemHooks_Delete_Call) Run(run func(ctx context.Context, hookID int, reqOpts ...RequestOption)) *MockSystemHooks_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		hookID, _ := args[1].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-2)
+		for _, _a := range args[2:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, hookID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Delete call
+func (_c *MockSystemHooks_Delete_Call) Return(_a0 error) *MockSystemHooks_Delete_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockSystemHooks_List_Call is an expectation of List call
+type MockSystemHooks_List_Call struct {
+	*mock.Call
+}
+
+// List sets expectation of List call, arguments are values or argument matchers
+func (_e *MockSystemHooks_Expecter) List(ctx interface{}, opts interface{}, reqOpts ...interface{}) *MockSystemHooks_List_Call {
+	_ca := append([]interface{}{ctx, opts}, reqOpts...)
+	return &MockSystemHooks_List_Call{Call: _e.mock.On("List", _ca...)}
+}
+
+// Run sets function called with arguments of List call
+func (_c *MockSystemHooks_List_Call) Run(run func(ctx context.Context, opts ListOptions, reqOpts ...RequestOption)) *MockSystemHooks_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		opts, _ := args[1].(ListOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-2)
+		for _, _a := range args[2:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by List call
+func (_c *MockSystemHooks_List_Call) Return(_a0 []SystemHook, _a1 error) *MockSystemHooks_List_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockSystemHooks_Test_Call is an expectation of Test call
+type MockSystemHooks_Test_Call struct {
+	*mock.Call
+}
+
+// Test sets expectation of Test call, arguments are values or argument matchers
+func (_e *MockSystemHooks_Expecter) Test(ctx interface{}, hookID interface{}, reqOpts ...interface{}) *MockSystemHooks_Test_Call {
+	_ca := append([]interface{}{ctx, hookID}, reqOpts...)
+	return &MockSystemHooks_Test_Call{Call: _e.mock.On("Test", _ca...)}
+}
+
+// Run sets function called with arguments of Test call
+func (_c *MockSystemHooks_Test_Call) Run(run func(ctx context.Context, hookID int, reqOpts ...RequestOption)) *MockSystemHooks_Test_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		hookID, _ := args[1].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-2)
+		for _, _a := range args[2:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, hookID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Test call
+func (_c *MockSystemHooks_Test_Call) Return(_a0 error) *MockSystemHooks_Test_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
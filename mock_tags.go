@@ -0,0 +1,265 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Tags = (*MockTags)(nil)
+
+// MockTags is an autogenerated mock type for the Tags type
+type MockTags struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockTags) Create(ctx context.Context, projectID int, opts CreateTagOptions, reqOpts ...RequestOption) (Tag, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Tag
+	if rf, ok := ret.Get(0).(func(context.Context, int, CreateTagOptions, ...RequestOption) Tag); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Tag)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, CreateTagOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, projectID, tag, reqOpts
+func (_m *MockTags) Delete(ctx context.Context, projectID int, tag string, reqOpts ...RequestOption) error {
+	_ca := []interface{}{ctx, projectID, tag}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, ...RequestOption) error); ok {
+		r0 = rf(ctx, projectID, tag, reqOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, projectID, tag, reqOpts
+func (_m *MockTags) Get(ctx context.Context, projectID int, tag string, reqOpts ...RequestOption) (Tag, error) {
+	_ca := []interface{}{ctx, projectID, tag}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 Tag
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, ...RequestOption) Tag); ok {
+		r0 = rf(ctx, projectID, tag, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(Tag)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, tag, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, projectID, opts, reqOpts
+func (_m *MockTags) List(ctx context.Context, projectID int, opts ListTagsOptions, reqOpts ...RequestOption) ([]Tag, error) {
+	_ca := []interface{}{ctx, projectID, opts}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []Tag
+	if rf, ok := ret.Get(0).(func(context.Context, int, ListTagsOptions, ...RequestOption) []Tag); ok {
+		r0 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ListTagsOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, projectID, opts, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTags_Expecter provides typed helpers to set expectations
+type MockTags_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockTags) EXPECT() *MockTags_Expecter {
+	return &MockTags_Expecter{mock: &_m.Mock}
+}
+
+// MockTags_Create_Call is an expectation of Create call
+type MockTags_Create_Call struct {
+	*mock.Call
+}
+
+// Create sets expectation of Create call, arguments are values or argument matchers
+func (_e *MockTags_Expecter) Create(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockTags_Create_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockTags_Create_Call{Call: _e.mock.On("Create", _ca...)}
+}
+
+// Run sets function called with arguments of Create call
+func (_c *MockTags_Create_Call) Run(run func(ctx context.Context, projectID int, opts CreateTagOptions, reqOpts ...RequestOption)) *MockTags_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(CreateTagOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Create call
+func (_c *MockTags_Create_Call) Return(_a0 Tag, _a1 error) *MockTags_Create_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockTags_Delete_Call is an expectation of Delete call
+type MockTags_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete sets expectation of Delete call, arguments are values or argument matchers
+func (_e *MockTags_Expecter) Delete(ctx interface{}, projectID interface{}, tag interface{}, reqOpts ...interface{}) *MockTags_Delete_Call {
+	_ca := append([]interface{}{ctx, projectID, tag}, reqOpts...)
+	return &MockTags_Delete_Call{Call: _e.mock.On("Delete", _ca...)}
+}
+
+// Run sets function called with arguments of Delete call
+func (_c *MockTags_Delete_Call) Run(run func(ctx context.Context, projectID int, tag string, reqOpts ...RequestOption)) *MockTags_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		tag, _ := args[2].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, tag, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Delete call
+func (_c *MockTags_Delete_Call) Return(_a0 error) *MockTags_Delete_Call {
+	_c.Call.Return(_a0)
+
+	return _c
+}
+
+// MockTags_Get_Call is an expectation of Get call
+type MockTags_Get_Call struct {
+	*mock.Call
+}
+
+// Get sets expectation of Get call, arguments are values or argument matchers
+func (_e *MockTags_Expecter) Get(ctx interface{}, projectID interface{}, tag interface{}, reqOpts ...interface{}) *MockTags_Get_Call {
+	_ca := append([]interface{}{ctx, projectID, tag}, reqOpts...)
+	return &MockTags_Get_Call{Call: _e.mock.On("Get", _ca...)}
+}
+
+// Run sets function called with arguments of Get call
+func (_c *MockTags_Get_Call) Run(run func(ctx context.Context, projectID int, tag string, reqOpts ...RequestOption)) *MockTags_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		tag, _ := args[2].(string)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, tag, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by Get call
+func (_c *MockTags_Get_Call) Return(_a0 Tag, _a1 error) *MockTags_Get_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockTags_List_Call is an expectation of List call
+type MockTags_List_Call struct {
+	*mock.Call
+}
+
+// List sets expectation of List call, arguments are values or argument matchers
+func (_e *MockTags_Expecter) List(ctx interface{}, projectID interface{}, opts interface{}, reqOpts ...interface{}) *MockTags_List_Call {
+	_ca := append([]interface{}{ctx, projectID, opts}, reqOpts...)
+	return &MockTags_List_Call{Call: _e.mock.On("List", _ca...)}
+}
+
+// Run sets function called with arguments of List call
+func (_c *MockTags_List_Call) Run(run func(ctx context.Context, projectID int, opts ListTagsOptions, reqOpts ...RequestOption)) *MockTags_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		projectID, _ := args[1].(int)
+		opts, _ := args[2].(ListTagsOptions)
+		reqOpts := make([]RequestOption, 0, len(args)-3)
+		for _, _a := range args[3:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, projectID, opts, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by List call
+func (_c *MockTags_List_Call) Return(_a0 []Tag, _a1 error) *MockTags_List_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
@@ -0,0 +1,148 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Users = (*MockUsers)(nil)
+
+// MockUsers is an autogenerated mock type for the Users type
+type MockUsers struct {
+	mock.Mock
+}
+
+// GetByID provides a mock function with given fields: ctx, userID, reqOpts
+func (_m *MockUsers) GetByID(ctx context.Context, userID int, reqOpts ...RequestOption) (User, error) {
+	_ca := []interface{}{ctx, userID}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 User
+	if rf, ok := ret.Get(0).(func(context.Context, int, ...RequestOption) User); ok {
+		r0 = rf(ctx, userID, reqOpts...)
+	} else {
+		r0 = ret.Get(0).(User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, userID, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByIDs provides a mock function with given fields: ctx, ids, reqOpts
+func (_m *MockUsers) GetByIDs(ctx context.Context, ids []int, reqOpts ...RequestOption) ([]User, error) {
+	_ca := []interface{}{ctx, ids}
+	for _, _a := range reqOpts {
+		_ca = append(_ca, _a)
+	}
+
+	ret := _m.Called(_ca...)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(context.Context, []int, ...RequestOption) []User); ok {
+		r0 = rf(ctx, ids, reqOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int, ...RequestOption) error); ok {
+		r1 = rf(ctx, ids, reqOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsers_Expecter provides typed helpers to set expectations
+type MockUsers_Expecter struct {
+	mock *mock.Mock
+}
+
+// EXPECT returns typed helpers to set expectations
+func (_m *MockUsers) EXPECT() *MockUsers_Expecter {
+	return &MockUsers_Expecter{mock: &_m.Mock}
+}
+
+// MockUsers_GetByID_Call is an expectation of GetByID call
+type MockUsers_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID sets expectation of GetByID call, arguments are values or argument matchers
+func (_e *MockUsers_Expecter) GetByID(ctx interface{}, userID interface{}, reqOpts ...interface{}) *MockUsers_GetByID_Call {
+	_ca := append([]interface{}{ctx, userID}, reqOpts...)
+	return &MockUsers_GetByID_Call{Call: _e.mock.On("GetByID", _ca...)}
+}
+
+// Run sets function called with arguments of GetByID call
+func (_c *MockUsers_GetByID_Call) Run(run func(ctx context.Context, userID int, reqOpts ...RequestOption)) *MockUsers_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		userID, _ := args[1].(int)
+		reqOpts := make([]RequestOption, 0, len(args)-2)
+		for _, _a := range args[2:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, userID, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetByID call
+func (_c *MockUsers_GetByID_Call) Return(_a0 User, _a1 error) *MockUsers_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
+
+// MockUsers_GetByIDs_Call is an expectation of GetByIDs call
+type MockUsers_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs sets expectation of GetByIDs call, arguments are values or argument matchers
+func (_e *MockUsers_Expecter) GetByIDs(ctx interface{}, ids interface{}, reqOpts ...interface{}) *MockUsers_GetByIDs_Call {
+	_ca := append([]interface{}{ctx, ids}, reqOpts...)
+	return &MockUsers_GetByIDs_Call{Call: _e.mock.On("GetByIDs", _ca...)}
+}
+
+// Run sets function called with arguments of GetByIDs call
+func (_c *MockUsers_GetByIDs_Call) Run(run func(ctx context.Context, ids []int, reqOpts ...RequestOption)) *MockUsers_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		ctx, _ := args[0].(context.Context)
+		ids, _ := args[1].([]int)
+		reqOpts := make([]RequestOption, 0, len(args)-2)
+		for _, _a := range args[2:] {
+			_v, _ := _a.(RequestOption)
+			reqOpts = append(reqOpts, _v)
+		}
+		run(ctx, ids, reqOpts...)
+	})
+
+	return _c
+}
+
+// Return sets values returned by GetByIDs call
+func (_c *MockUsers_GetByIDs_Call) Return(_a0 []User, _a1 error) *MockUsers_GetByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+
+	return _c
+}
@@ -0,0 +1,37 @@
+// Package gitlab - pagination
+package gitlab
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// maxPerPage is the maximum page size allowed by gitlab
+const maxPerPage = 100
+
+// ListOptions contains pagination parameters of list requests
+type ListOptions struct {
+	Page    int
+	PerPage int
+}
+
+func (opts ListOptions) values() url.Values {
+	values := url.Values{}
+	if opts.Page > 0 {
+		values.Set("page", strconv.Itoa(opts.Page))
+	}
+
+	if opts.PerPage > 0 {
+		values.Set("per_page", strconv.Itoa(opts.PerPage))
+	}
+
+	return values
+}
+
+func withQuery(path string, values url.Values) string {
+	if len(values) == 0 {
+		return path
+	}
+
+	return path + "?" + values.Encode()
+}
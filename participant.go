@@ -0,0 +1,208 @@
+// Package gitlab - merge request participants
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ParticipantRole is a role of merge request participant
+type ParticipantRole string
+
+// Participant roles
+const (
+	RoleAuthor    ParticipantRole = "author"
+	RoleReviewer  ParticipantRole = "reviewer"
+	RoleAssignee  ParticipantRole = "assignee"
+	RoleApprover  ParticipantRole = "approver"
+	RoleCommenter ParticipantRole = "commenter"
+)
+
+// gitlab project and group access token users are named like project_123_bot or group_45_bot_a1b2
+var botUserNameRegexp = regexp.MustCompile(`^(project|group)_\d+_bot`)
+
+type (
+	// Participant of merge request with its roles and activity
+	Participant struct {
+		User  NoteAuthor
+		Roles []ParticipantRole
+		Bot   bool
+		// Notes is a number of non-system notes of the participant
+		Notes int
+		// SystemNotes is a number of system notes (approvals, pushes, label changes etc.) of the participant
+		SystemNotes int
+		// UnresolvedThreads is a number of unresolved threads started by the participant
+		UnresolvedThreads int
+		// FirstActivityAt and LastActivityAt are zero if participant has no notes
+		FirstActivityAt time.Time
+		LastActivityAt  time.Time
+	}
+
+	// ParticipantsReportOptions contains parameters of merge request participants report
+	ParticipantsReportOptions struct {
+		// ExcludeSystemNotes ignores system notes, so users having system notes only are not reported
+		ExcludeSystemNotes bool
+		// ExcludeBots removes bots from report
+		ExcludeBots bool
+		// IsBot overrides default bot detection based on gitlab bot user names
+		IsBot func(user NoteAuthor) bool
+	}
+)
+
+// HasRole reports whether participant has the role
+func (p Participant) HasRole(role ParticipantRole) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getMergeRequestParticipants(
+	ctx context.Context,
+	c *client,
+	projectID, mrID int,
+	opts ParticipantsReportOptions,
+) ([]Participant, error) {
+	mr, err := c.GetMergeRequest(ctx, projectID, mrID)
+	if err != nil {
+		return nil, fmt.Errorf("can't get merge request from gitlab: %w", err)
+	}
+
+	approvals, err := c.GetMergeRequestApprovals(ctx, projectID, mrID)
+	if err != nil {
+		return nil, fmt.Errorf("can't get merge request approvals from gitlab: %w", err)
+	}
+
+	discussions, err := listAllDiscussions(ctx, c, projectID, mrID)
+	if err != nil {
+		return nil, fmt.Errorf("can't get discussions from gitlab: %w", err)
+	}
+
+	isBot := opts.IsBot
+	if isBot == nil {
+		isBot = isBotUser
+	}
+
+	report := newParticipantsReport()
+
+	report.addRole(mr.Author, RoleAuthor)
+	for _, user := range mr.Reviewers {
+		report.addRole(user, RoleReviewer)
+	}
+
+	for _, user := range mr.Assignees {
+		report.addRole(user, RoleAssignee)
+	}
+
+	for _, approver := range approvals.ApprovedBy {
+		report.addRole(approver.User, RoleApprover)
+	}
+
+	for _, discussion := range discussions {
+		for _, note := range discussion.Notes {
+			if note.System && opts.ExcludeSystemNotes {
+				continue
+			}
+
+			report.addNote(note)
+		}
+
+		if len(discussion.Notes) > 0 && isUnresolved(discussion) {
+			report.get(discussion.Notes[0].Author).UnresolvedThreads++
+		}
+	}
+
+	participants := make([]Participant, 0, len(report.order))
+	for _, id := range report.order {
+		participant := report.participants[id]
+		participant.Bot = isBot(participant.User)
+
+		if participant.Bot && opts.ExcludeBots {
+			continue
+		}
+
+		participants = append(participants, *participant)
+	}
+
+	sort.SliceStable(participants, func(i, j int) bool {
+		return participants[i].FirstActivityAt.Before(participants[j].FirstActivityAt)
+	})
+
+	return participants, nil
+}
+
+type participantsReport struct {
+	participants map[int]*Participant
+	order        []int
+}
+
+func newParticipantsReport() *participantsReport {
+	return &participantsReport{participants: map[int]*Participant{}}
+}
+
+func (r *participantsReport) get(user NoteAuthor) *Participant {
+	participant, has := r.participants[user.ID]
+	if !has {
+		participant = &Participant{User: user}
+		r.participants[user.ID] = participant
+		r.order = append(r.order, user.ID)
+	}
+
+	return participant
+}
+
+func (r *participantsReport) addRole(user NoteAuthor, role ParticipantRole) {
+	participant := r.get(user)
+	if !participant.HasRole(role) {
+		participant.Roles = append(participant.Roles, role)
+	}
+}
+
+func (r *participantsReport) addNote(note Note) {
+	participant := r.get(note.Author)
+
+	if note.System {
+		participant.SystemNotes++
+	} else {
+		participant.Notes++
+		r.addRole(note.Author, RoleCommenter)
+	}
+
+	if note.CreatedAt == nil {
+		return
+	}
+
+	if participant.FirstActivityAt.IsZero() || note.CreatedAt.Before(participant.FirstActivityAt) {
+		participant.FirstActivityAt = note.CreatedAt.Time
+	}
+
+	if note.CreatedAt.After(participant.LastActivityAt) {
+		participant.LastActivityAt = note.CreatedAt.Time
+	}
+}
+
+// isUnresolved reports whether discussion has resolvable notes which are not resolved
+func isUnresolved(discussion Discussion) bool {
+	for _, note := range discussion.Notes {
+		if note.Resolvable && !note.Resolved {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isBotUser(user NoteAuthor) bool {
+	name := strings.ToLower(user.UserName)
+	return botUserNameRegexp.MatchString(name) ||
+		strings.HasSuffix(name, "-bot") ||
+		strings.HasSuffix(name, "_bot") ||
+		strings.HasSuffix(name, "[bot]")
+}
@@ -0,0 +1,162 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestClient_GetMergeRequestParticipants(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	responses := map[string]string{
+		"/projects/10/merge_requests/20": `{
+			"iid": 20,
+			"author": {"id": 1, "username": "author"},
+			"reviewers": [{"id": 2, "username": "reviewer"}],
+			"assignees": [{"id": 1, "username": "author"}]
+		}`,
+		"/projects/10/merge_requests/20/approvals": `{"approved_by": [{"user": {"id": 2, "username": "reviewer"}}]}`,
+		"/projects/10/merge_requests/20/discussions?page=1&per_page=100": `[
+			{"id": "d1", "notes": [
+				{"id": 1, "author": {"id": 2, "username": "reviewer"}, "created_at": "2020-10-01T10:00:00.000Z", "resolvable": true},
+				{"id": 2, "author": {"id": 1, "username": "author"}, "created_at": "2020-10-01T11:00:00.000Z", "resolvable": true}
+			]},
+			{"id": "d2", "notes": [
+				{"id": 3, "author": {"id": 3, "username": "commenter"}, "created_at": "2020-10-02T10:00:00.000Z",
+				"resolvable": true, "resolved": true}
+			]},
+			{"id": "d3", "individual_note": true, "notes": [
+				{"id": 4, "author": {"id": 2, "username": "reviewer"}, "created_at": "2020-10-03T10:00:00.000Z", "system": true}
+			]},
+			{"id": "d4", "individual_note": true, "notes": [
+				{"id": 5, "author": {"id": 4, "username": "project_10_bot"}, "created_at": "2020-10-04T10:00:00.000Z"}
+			]}
+		]`,
+	}
+
+	newClient := func() gitlab.Client {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(
+			func(req *http.Request) *http.Response {
+				body, ok := responses[req.URL.RequestURI()[len("/api/v4"):]]
+				assert.True(t, ok, req.URL.String())
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+					StatusCode: http.StatusOK,
+				}
+			},
+			nil,
+		)
+
+		return gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+	}
+
+	parseTime := func(s string) time.Time {
+		tm, err := time.Parse(time.RFC3339, s)
+		assert.NoError(t, err)
+		return tm
+	}
+
+	t.Run("positive case", func(t *testing.T) {
+		participants, err := newClient().MergeRequests().GetParticipants(context.Background(), 10, 20, gitlab.ParticipantsReportOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, []gitlab.Participant{
+			{
+				User:              gitlab.NoteAuthor{ID: 2, UserName: "reviewer"},
+				Roles:             []gitlab.ParticipantRole{gitlab.RoleReviewer, gitlab.RoleApprover, gitlab.RoleCommenter},
+				Notes:             1,
+				SystemNotes:       1,
+				UnresolvedThreads: 1,
+				FirstActivityAt:   parseTime("2020-10-01T10:00:00.000Z"),
+				LastActivityAt:    parseTime("2020-10-03T10:00:00.000Z"),
+			},
+			{
+				User:            gitlab.NoteAuthor{ID: 1, UserName: "author"},
+				Roles:           []gitlab.ParticipantRole{gitlab.RoleAuthor, gitlab.RoleAssignee, gitlab.RoleCommenter},
+				Notes:           1,
+				FirstActivityAt: parseTime("2020-10-01T11:00:00.000Z"),
+				LastActivityAt:  parseTime("2020-10-01T11:00:00.000Z"),
+			},
+			{
+				User:            gitlab.NoteAuthor{ID: 3, UserName: "commenter"},
+				Roles:           []gitlab.ParticipantRole{gitlab.RoleCommenter},
+				Notes:           1,
+				FirstActivityAt: parseTime("2020-10-02T10:00:00.000Z"),
+				LastActivityAt:  parseTime("2020-10-02T10:00:00.000Z"),
+			},
+			{
+				User:            gitlab.NoteAuthor{ID: 4, UserName: "project_10_bot"},
+				Roles:           []gitlab.ParticipantRole{gitlab.RoleCommenter},
+				Bot:             true,
+				Notes:           1,
+				FirstActivityAt: parseTime("2020-10-04T10:00:00.000Z"),
+				LastActivityAt:  parseTime("2020-10-04T10:00:00.000Z"),
+			},
+		}, participants)
+	})
+
+	t.Run("exclude system notes and bots", func(t *testing.T) {
+		participants, err := newClient().MergeRequests().GetParticipants(context.Background(), 10, 20, gitlab.ParticipantsReportOptions{
+			ExcludeSystemNotes: true,
+			ExcludeBots:        true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(participants))
+
+		assert.Equal(t, 2, participants[0].User.ID)
+		assert.Equal(t, 0, participants[0].SystemNotes)
+		assert.Equal(t, parseTime("2020-10-01T10:00:00.000Z"), participants[0].LastActivityAt)
+
+		for _, participant := range participants {
+			assert.False(t, participant.Bot)
+		}
+	})
+
+	t.Run("custom bot detection", func(t *testing.T) {
+		participants, err := newClient().MergeRequests().GetParticipants(context.Background(), 10, 20, gitlab.ParticipantsReportOptions{
+			ExcludeBots: true,
+			IsBot: func(user gitlab.NoteAuthor) bool {
+				return user.UserName == "commenter"
+			},
+		})
+		assert.NoError(t, err)
+
+		ids := make([]int, 0, len(participants))
+		for _, participant := range participants {
+			ids = append(ids, participant.User.ID)
+		}
+
+		assert.Equal(t, []int{2, 1, 4}, ids)
+	})
+
+	t.Run("error on getting merge request", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expErr)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		participants, err := client.MergeRequests().GetParticipants(context.Background(), 10, 20, gitlab.ParticipantsReportOptions{})
+		assert.True(t, errors.Is(err, expErr))
+		assert.Equal(t, []gitlab.Participant(nil), participants)
+	})
+}
@@ -0,0 +1,281 @@
+// Package gitlab - position
+package gitlab
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// DiffSide is a side of diff the line number refers to
+type DiffSide string
+
+// Diff sides
+const (
+	DiffSideNew DiffSide = "new"
+	DiffSideOld DiffSide = "old"
+)
+
+// Position types
+const (
+	PositionTypeText  = "text"
+	PositionTypeImage = "image"
+)
+
+// ErrPositionNotFound is returned when requested file or line is absent in merge request diff
+var ErrPositionNotFound = errors.New("position not found in merge request diff")
+
+type (
+	// BuildPositionOptions contains parameters of text diff position
+	BuildPositionOptions struct {
+		// Path of the file, new path for all lines except removed ones of renamed files
+		Path string
+		// Side of diff Line and EndLine refer to, new by default
+		Side DiffSide
+		// Line is a number of the (first) commented line
+		Line int
+		// EndLine is a number of the last commented line of multi-line note, optional
+		EndLine int
+	}
+
+	// BuildImagePositionOptions contains parameters of image diff position
+	BuildImagePositionOptions struct {
+		// Path of the image file
+		Path   string
+		Width  int
+		Height int
+		X      int
+		Y      int
+	}
+
+	resolvedLine struct {
+		lineType DiffLineType
+		oldLine  int
+		newLine  int
+		// line code counters (gitlab keeps counting lines of both sides for added and removed lines)
+		oldPos int
+		newPos int
+	}
+)
+
+func buildPosition(ctx context.Context, c *client, projectID, mrID int, opts BuildPositionOptions) (Position, error) {
+	side := opts.Side
+	if side == "" {
+		side = DiffSideNew
+	}
+
+	if side != DiffSideNew && side != DiffSideOld {
+		return Position{}, fmt.Errorf("invalid diff side %q", side)
+	}
+
+	if opts.EndLine != 0 && opts.EndLine < opts.Line {
+		return Position{}, fmt.Errorf("end line %d is less than line %d", opts.EndLine, opts.Line)
+	}
+
+	version, diff, err := findVersionDiff(ctx, c, projectID, mrID, opts.Path)
+	if err != nil {
+		return Position{}, err
+	}
+
+	hunks, err := diff.Hunks()
+	if err != nil {
+		return Position{}, fmt.Errorf("can't parse diff of %s: %w", opts.Path, err)
+	}
+
+	outside := !diff.NewFile && !diff.DeletedFile
+
+	start, ok := resolveLine(hunks, side, opts.Line, outside)
+	if !ok {
+		return Position{}, fmt.Errorf("%s line %d of %s: %w", side, opts.Line, opts.Path, ErrPositionNotFound)
+	}
+
+	end := start
+	if opts.EndLine != 0 && opts.EndLine != opts.Line {
+		if end, ok = resolveLine(hunks, side, opts.EndLine, outside); !ok {
+			return Position{}, fmt.Errorf("%s line %d of %s: %w", side, opts.EndLine, opts.Path, ErrPositionNotFound)
+		}
+	}
+
+	position := newVersionPosition(version, diff, PositionTypeText)
+	position.OldLine, position.NewLine = end.oldLine, end.newLine
+
+	if opts.EndLine != 0 && opts.EndLine != opts.Line {
+		position.LineRange = &LineRange{
+			Start: start.linePosition(diff.NewPath),
+			End:   end.linePosition(diff.NewPath),
+		}
+	}
+
+	return position, nil
+}
+
+func buildImagePosition(
+	ctx context.Context,
+	c *client,
+	projectID, mrID int,
+	opts BuildImagePositionOptions,
+) (Position, error) {
+	version, diff, err := findVersionDiff(ctx, c, projectID, mrID, opts.Path)
+	if err != nil {
+		return Position{}, err
+	}
+
+	position := newVersionPosition(version, diff, PositionTypeImage)
+	position.Width, position.Height = opts.Width, opts.Height
+	position.X, position.Y = opts.X, opts.Y
+
+	return position, nil
+}
+
+// findVersionDiff returns latest merge request diff version and diff of the file by its new or old path
+func findVersionDiff(
+	ctx context.Context,
+	c *client,
+	projectID, mrID int,
+	path string,
+) (MergeRequestDiffVersion, Diff, error) {
+	versions, err := c.ListMergeRequestDiffVersions(ctx, projectID, mrID)
+	if err != nil {
+		return MergeRequestDiffVersion{}, Diff{}, fmt.Errorf("can't get merge request diff versions: %w", err)
+	}
+
+	if len(versions) == 0 {
+		return MergeRequestDiffVersion{}, Diff{}, fmt.Errorf("merge request has no diff versions: %w", ErrPositionNotFound)
+	}
+
+	latest := versions[0]
+	for _, v := range versions[1:] {
+		if v.ID > latest.ID {
+			latest = v
+		}
+	}
+
+	version, err := c.GetMergeRequestDiffVersion(ctx, projectID, mrID, latest.ID)
+	if err != nil {
+		return MergeRequestDiffVersion{}, Diff{}, fmt.Errorf("can't get merge request diff version: %w", err)
+	}
+
+	for _, diff := range version.Diffs {
+		if diff.NewPath == path || diff.OldPath == path {
+			return version, diff, nil
+		}
+	}
+
+	return MergeRequestDiffVersion{}, Diff{}, fmt.Errorf("file %s: %w", path, ErrPositionNotFound)
+}
+
+func newVersionPosition(version MergeRequestDiffVersion, diff Diff, positionType string) Position {
+	return Position{
+		BaseSha:      version.BaseCommitSha,
+		StartSha:     version.StartCommitSha,
+		HeadSha:      version.HeadCommitSha,
+		OldPath:      diff.OldPath,
+		NewPath:      diff.NewPath,
+		PositionType: positionType,
+	}
+}
+
+// resolveLine finds line of diff by number of its side,
+// unchanged lines between hunks are resolved if outside is true
+func resolveLine(hunks []DiffHunk, side DiffSide, line int, outside bool) (resolvedLine, bool) {
+	if line <= 0 {
+		return resolvedLine{}, false
+	}
+
+	// delta is a difference between new and old numbers of unchanged lines
+	delta := 0
+	for _, hunk := range hunks {
+		first := hunkRangeFirst(hunk.NewStart, hunk.NewLines)
+		if side == DiffSideOld {
+			first = hunkRangeFirst(hunk.OldStart, hunk.OldLines)
+		}
+
+		if line < first {
+			break
+		}
+
+		oldPos, newPos := hunk.OldStart, hunk.NewStart
+		for _, l := range hunk.Lines {
+			matched := (side == DiffSideNew && l.Type != DiffLineRemoved && l.NewLine == line) ||
+				(side == DiffSideOld && l.Type != DiffLineAdded && l.OldLine == line)
+			if matched {
+				return resolvedLine{
+					lineType: l.Type,
+					oldLine:  l.OldLine,
+					newLine:  l.NewLine,
+					oldPos:   oldPos,
+					newPos:   newPos,
+				}, true
+			}
+
+			if l.Type != DiffLineAdded {
+				oldPos++
+			}
+
+			if l.Type != DiffLineRemoved {
+				newPos++
+			}
+		}
+
+		delta = hunkRangeEnd(hunk.NewStart, hunk.NewLines) - hunkRangeEnd(hunk.OldStart, hunk.OldLines)
+	}
+
+	if !outside {
+		return resolvedLine{}, false
+	}
+
+	oldLine, newLine := line-delta, line
+	if side == DiffSideOld {
+		oldLine, newLine = line, line+delta
+	}
+
+	return resolvedLine{
+		lineType: DiffLineContext,
+		oldLine:  oldLine,
+		newLine:  newLine,
+		oldPos:   oldLine,
+		newPos:   newLine,
+	}, true
+}
+
+// linePosition returns line range position, type is "new" for added lines, "old" for removed ones
+// and empty for context lines which refer to both sides
+func (l resolvedLine) linePosition(path string) LinePosition {
+	position := LinePosition{
+		LineCode: lineCode(path, l.oldPos, l.newPos),
+		OldLine:  l.oldLine,
+		NewLine:  l.newLine,
+	}
+
+	switch l.lineType {
+	case DiffLineAdded:
+		position.Type = string(DiffSideNew)
+	case DiffLineRemoved:
+		position.Type = string(DiffSideOld)
+	}
+
+	return position
+}
+
+// lineCode returns gitlab line code: sha1 of file path with old and new line positions
+func lineCode(path string, oldPos, newPos int) string {
+	sum := sha1.Sum([]byte(path))
+	return hex.EncodeToString(sum[:]) + "_" + strconv.Itoa(oldPos) + "_" + strconv.Itoa(newPos)
+}
+
+// hunkRangeFirst returns first line of hunk range, empty range starts after the line it refers to
+func hunkRangeFirst(start, count int) int {
+	if count == 0 {
+		return start + 1
+	}
+
+	return start
+}
+
+// hunkRangeEnd returns line following the hunk range
+func hunkRangeEnd(start, count int) int {
+	return hunkRangeFirst(start, count) + count
+}
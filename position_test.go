@@ -0,0 +1,218 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func lineCode(path string, oldPos, newPos int) string {
+	sum := sha1.Sum([]byte(path))
+	return fmt.Sprintf("%s_%d_%d", hex.EncodeToString(sum[:]), oldPos, newPos)
+}
+
+func TestClient_BuildPosition(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	version, err := json.Marshal(gitlab.MergeRequestDiffVersion{
+		ID:             2,
+		BaseCommitSha:  "base",
+		StartCommitSha: "start",
+		HeadCommitSha:  "head",
+		Diffs: []gitlab.Diff{
+			{
+				OldPath: "main.go",
+				NewPath: "main.go",
+				Diff: "@@ -3,3 +3,4 @@ package main\n" +
+					" first\n" +
+					"-second\n" +
+					"+second changed\n" +
+					"+inserted\n" +
+					" third\n",
+			},
+			{
+				OldPath: "new.go",
+				NewPath: "new.go",
+				NewFile: true,
+				Diff:    "@@ -0,0 +1,2 @@\n+package main\n+\n",
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	position := func(oldLine, newLine int, lineRange *gitlab.LineRange) gitlab.Position {
+		return gitlab.Position{
+			BaseSha:      "base",
+			StartSha:     "start",
+			HeadSha:      "head",
+			OldPath:      "main.go",
+			NewPath:      "main.go",
+			PositionType: gitlab.PositionTypeText,
+			OldLine:      oldLine,
+			NewLine:      newLine,
+			LineRange:    lineRange,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		opts        gitlab.BuildPositionOptions
+		expPosition gitlab.Position
+		expErr      error
+	}{
+		"added line": {
+			opts:        gitlab.BuildPositionOptions{Path: "main.go", Line: 5},
+			expPosition: position(0, 5, nil),
+		},
+		"removed line": {
+			opts:        gitlab.BuildPositionOptions{Path: "main.go", Side: gitlab.DiffSideOld, Line: 4},
+			expPosition: position(4, 0, nil),
+		},
+		"unchanged line in hunk": {
+			opts:        gitlab.BuildPositionOptions{Path: "main.go", Line: 6},
+			expPosition: position(5, 6, nil),
+		},
+		"unchanged line before hunk": {
+			opts:        gitlab.BuildPositionOptions{Path: "main.go", Line: 1},
+			expPosition: position(1, 1, nil),
+		},
+		"unchanged line after hunk": {
+			opts:        gitlab.BuildPositionOptions{Path: "main.go", Line: 10},
+			expPosition: position(9, 10, nil),
+		},
+		"multi-line ending on context line": {
+			opts: gitlab.BuildPositionOptions{Path: "main.go", Line: 4, EndLine: 6},
+			expPosition: position(5, 6, &gitlab.LineRange{
+				Start: gitlab.LinePosition{LineCode: lineCode("main.go", 5, 4), Type: "new", NewLine: 4},
+				End:   gitlab.LinePosition{LineCode: lineCode("main.go", 5, 6), OldLine: 5, NewLine: 6},
+			}),
+		},
+		"multi-line starting on context line": {
+			opts: gitlab.BuildPositionOptions{Path: "main.go", Line: 3, EndLine: 5},
+			expPosition: position(0, 5, &gitlab.LineRange{
+				Start: gitlab.LinePosition{LineCode: lineCode("main.go", 3, 3), OldLine: 3, NewLine: 3},
+				End:   gitlab.LinePosition{LineCode: lineCode("main.go", 5, 5), Type: "new", NewLine: 5},
+			}),
+		},
+		"line absent in new file": {
+			opts:   gitlab.BuildPositionOptions{Path: "new.go", Line: 3},
+			expErr: gitlab.ErrPositionNotFound,
+		},
+		"file absent in diff": {
+			opts:   gitlab.BuildPositionOptions{Path: "absent.go", Line: 1},
+			expErr: gitlab.ErrPositionNotFound,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			httpClient := new(gitlab.MockHTTPClient)
+			httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+				req, ok := args.Get(0).(*http.Request)
+				assert.True(t, ok)
+
+				assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/versions", req.URL.String())
+			}).Return(&http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`[{"id": 1}, {"id": 2}]`))),
+				StatusCode: http.StatusOK,
+			}, nil).Once()
+
+			httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+				req, ok := args.Get(0).(*http.Request)
+				assert.True(t, ok)
+
+				assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/versions/2", req.URL.String())
+			}).Return(&http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader(version)),
+				StatusCode: http.StatusOK,
+			}, nil).Once()
+
+			client := gitlab.NewClient(
+				"test_token",
+				gitlab.WithBaseUrl(baseUrl),
+				gitlab.WithHttpClient(httpClient),
+			)
+
+			res, err := client.MergeRequests().BuildPosition(context.Background(), 10, 20, tc.opts)
+			if tc.expErr != nil {
+				assert.True(t, errors.Is(err, tc.expErr))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expPosition, res)
+		})
+	}
+
+	t.Run("error on getting versions", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expErr)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		position, err := client.MergeRequests().BuildPosition(context.Background(), 10, 20, gitlab.BuildPositionOptions{
+			Path: "main.go",
+			Line: 1,
+		})
+		assert.True(t, errors.Is(err, expErr))
+		assert.Equal(t, gitlab.Position{}, position)
+	})
+}
+
+func TestClient_BuildImagePosition(t *testing.T) {
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`[{"id": 2}]`))),
+		StatusCode: http.StatusOK,
+	}, nil).Once()
+
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewReader([]byte(`{
+			"id": 2,
+			"base_commit_sha": "base",
+			"start_commit_sha": "start",
+			"head_commit_sha": "head",
+			"diffs": [{"old_path": "logo.png", "new_path": "logo.png"}]
+		}`))),
+		StatusCode: http.StatusOK,
+	}, nil).Once()
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	position, err := client.MergeRequests().BuildImagePosition(context.Background(), 10, 20, gitlab.BuildImagePositionOptions{
+		Path:   "logo.png",
+		Width:  100,
+		Height: 50,
+		X:      10,
+		Y:      20,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, gitlab.Position{
+		BaseSha:      "base",
+		StartSha:     "start",
+		HeadSha:      "head",
+		OldPath:      "logo.png",
+		NewPath:      "logo.png",
+		PositionType: gitlab.PositionTypeImage,
+		Width:        100,
+		Height:       50,
+		X:            10,
+		Y:            20,
+	}, position)
+}
@@ -0,0 +1,196 @@
+// Package gitlab - protected branches and tags
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// AccessLevel is a gitlab permission level
+type AccessLevel int
+
+// Access levels
+const (
+	NoAccess         AccessLevel = 0
+	DeveloperAccess  AccessLevel = 30
+	MaintainerAccess AccessLevel = 40
+	AdminAccess      AccessLevel = 60
+)
+
+type (
+	// ProtectedAccessLevel entity describes who is allowed to perform an action on protected ref
+	ProtectedAccessLevel struct {
+		ID                     int         `json:"id"`
+		AccessLevel            AccessLevel `json:"access_level"`
+		AccessLevelDescription string      `json:"access_level_description"`
+		UserID                 int         `json:"user_id"`
+		GroupID                int         `json:"group_id"`
+	}
+
+	// ProtectedBranch entity
+	ProtectedBranch struct {
+		ID                        int                    `json:"id"`
+		Name                      string                 `json:"name"`
+		PushAccessLevels          []ProtectedAccessLevel `json:"push_access_levels"`
+		MergeAccessLevels         []ProtectedAccessLevel `json:"merge_access_levels"`
+		UnprotectAccessLevels     []ProtectedAccessLevel `json:"unprotect_access_levels"`
+		AllowForcePush            bool                   `json:"allow_force_push"`
+		CodeOwnerApprovalRequired bool                   `json:"code_owner_approval_required"`
+	}
+
+	// ProtectedTag entity
+	ProtectedTag struct {
+		Name               string                 `json:"name"`
+		CreateAccessLevels []ProtectedAccessLevel `json:"create_access_levels"`
+	}
+
+	// AccessLevelOption grants permission to a user, a group or an access level
+	AccessLevelOption struct {
+		UserID      *int         `json:"user_id,omitempty"`
+		GroupID     *int         `json:"group_id,omitempty"`
+		AccessLevel *AccessLevel `json:"access_level,omitempty"`
+	}
+
+	// ProtectBranchOptions contains parameters of branch protection request
+	ProtectBranchOptions struct {
+		Name                      string              `json:"name"`
+		PushAccessLevel           *AccessLevel        `json:"push_access_level,omitempty"`
+		MergeAccessLevel          *AccessLevel        `json:"merge_access_level,omitempty"`
+		UnprotectAccessLevel      *AccessLevel        `json:"unprotect_access_level,omitempty"`
+		AllowForcePush            *bool               `json:"allow_force_push,omitempty"`
+		CodeOwnerApprovalRequired *bool               `json:"code_owner_approval_required,omitempty"`
+		AllowedToPush             []AccessLevelOption `json:"allowed_to_push,omitempty"`
+		AllowedToMerge            []AccessLevelOption `json:"allowed_to_merge,omitempty"`
+		AllowedToUnprotect        []AccessLevelOption `json:"allowed_to_unprotect,omitempty"`
+	}
+
+	// UpdateProtectedBranchOptions contains parameters of protected branch update request
+	UpdateProtectedBranchOptions struct {
+		AllowForcePush            *bool `json:"allow_force_push,omitempty"`
+		CodeOwnerApprovalRequired *bool `json:"code_owner_approval_required,omitempty"`
+	}
+
+	// ProtectTagOptions contains parameters of tag protection request
+	ProtectTagOptions struct {
+		Name              string              `json:"name"`
+		CreateAccessLevel *AccessLevel        `json:"create_access_level,omitempty"`
+		AllowedToCreate   []AccessLevelOption `json:"allowed_to_create,omitempty"`
+	}
+)
+
+func listProtectedBranches(ctx context.Context, c *client, projectID int, opts ListOptions) ([]ProtectedBranch, error) {
+	path := fmt.Sprintf("projects/%d/protected_branches", projectID)
+	resp, err := c.get(ctx, withQuery(path, opts.values()))
+	if err != nil {
+		return nil, err
+	}
+
+	var branches []ProtectedBranch
+	if err = json.Unmarshal(resp, &branches); err != nil {
+		return nil, fmt.Errorf("can't unmarshal protected branches data: %w", err)
+	}
+
+	return branches, nil
+}
+
+func getProtectedBranch(ctx context.Context, c *client, projectID int, name string) (ProtectedBranch, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("projects/%d/protected_branches/%s", projectID, url.PathEscape(name)))
+	if err != nil {
+		return ProtectedBranch{}, err
+	}
+
+	var branch ProtectedBranch
+	if err = json.Unmarshal(resp, &branch); err != nil {
+		return ProtectedBranch{}, fmt.Errorf("can't unmarshal protected branch data: %w", err)
+	}
+
+	return branch, nil
+}
+
+func protectBranch(ctx context.Context, c *client, projectID int, opts ProtectBranchOptions) (ProtectedBranch, error) {
+	resp, err := c.post(ctx, fmt.Sprintf("projects/%d/protected_branches", projectID), opts)
+	if err != nil {
+		return ProtectedBranch{}, err
+	}
+
+	var branch ProtectedBranch
+	if err = json.Unmarshal(resp, &branch); err != nil {
+		return ProtectedBranch{}, fmt.Errorf("can't unmarshal protected branch data: %w", err)
+	}
+
+	return branch, nil
+}
+
+func updateProtectedBranch(
+	ctx context.Context,
+	c *client,
+	projectID int,
+	name string,
+	opts UpdateProtectedBranchOptions,
+) (ProtectedBranch, error) {
+	path := fmt.Sprintf("projects/%d/protected_branches/%s", projectID, url.PathEscape(name))
+	resp, err := c.patch(ctx, path, opts)
+	if err != nil {
+		return ProtectedBranch{}, err
+	}
+
+	var branch ProtectedBranch
+	if err = json.Unmarshal(resp, &branch); err != nil {
+		return ProtectedBranch{}, fmt.Errorf("can't unmarshal protected branch data: %w", err)
+	}
+
+	return branch, nil
+}
+
+func unprotectBranch(ctx context.Context, c *client, projectID int, name string) error {
+	return c.delete(ctx, fmt.Sprintf("projects/%d/protected_branches/%s", projectID, url.PathEscape(name)))
+}
+
+func listProtectedTags(ctx context.Context, c *client, projectID int, opts ListOptions) ([]ProtectedTag, error) {
+	path := fmt.Sprintf("projects/%d/protected_tags", projectID)
+	resp, err := c.get(ctx, withQuery(path, opts.values()))
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []ProtectedTag
+	if err = json.Unmarshal(resp, &tags); err != nil {
+		return nil, fmt.Errorf("can't unmarshal protected tags data: %w", err)
+	}
+
+	return tags, nil
+}
+
+func getProtectedTag(ctx context.Context, c *client, projectID int, name string) (ProtectedTag, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("projects/%d/protected_tags/%s", projectID, url.PathEscape(name)))
+	if err != nil {
+		return ProtectedTag{}, err
+	}
+
+	var tag ProtectedTag
+	if err = json.Unmarshal(resp, &tag); err != nil {
+		return ProtectedTag{}, fmt.Errorf("can't unmarshal protected tag data: %w", err)
+	}
+
+	return tag, nil
+}
+
+func protectTag(ctx context.Context, c *client, projectID int, opts ProtectTagOptions) (ProtectedTag, error) {
+	resp, err := c.post(ctx, fmt.Sprintf("projects/%d/protected_tags", projectID), opts)
+	if err != nil {
+		return ProtectedTag{}, err
+	}
+
+	var tag ProtectedTag
+	if err = json.Unmarshal(resp, &tag); err != nil {
+		return ProtectedTag{}, fmt.Errorf("can't unmarshal protected tag data: %w", err)
+	}
+
+	return tag, nil
+}
+
+func unprotectTag(ctx context.Context, c *client, projectID int, name string) error {
+	return c.delete(ctx, fmt.Sprintf("projects/%d/protected_tags/%s", projectID, url.PathEscape(name)))
+}
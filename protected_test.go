@@ -0,0 +1,222 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestClient_ProtectBranch(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		var (
+			baseUrl    = "http://gitlab.test.com/api/v4"
+			maintainer = gitlab.MaintainerAccess
+			noAccess   = gitlab.NoAccess
+			userID     = 5
+			required   = true
+		)
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, baseUrl+"/projects/10/protected_branches", req.URL.String())
+
+			body, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{
+				"name": "release-*",
+				"push_access_level": 0,
+				"merge_access_level": 40,
+				"code_owner_approval_required": true,
+				"allowed_to_push": [{"user_id": 5}]
+			}`, string(body))
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(`{
+				"id": 1,
+				"name": "release-*",
+				"push_access_levels": [{"access_level": 0, "access_level_description": "No one"}],
+				"merge_access_levels": [{"access_level": 40, "access_level_description": "Maintainers"}],
+				"code_owner_approval_required": true
+			}`))),
+			StatusCode: http.StatusCreated,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		branch, err := client.ProtectedBranches().Protect(context.Background(), 10, gitlab.ProtectBranchOptions{
+			Name:                      "release-*",
+			PushAccessLevel:           &noAccess,
+			MergeAccessLevel:          &maintainer,
+			CodeOwnerApprovalRequired: &required,
+			AllowedToPush:             []gitlab.AccessLevelOption{{UserID: &userID}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.ProtectedBranch{
+			ID:   1,
+			Name: "release-*",
+			PushAccessLevels: []gitlab.ProtectedAccessLevel{
+				{AccessLevel: gitlab.NoAccess, AccessLevelDescription: "No one"},
+			},
+			MergeAccessLevels: []gitlab.ProtectedAccessLevel{
+				{AccessLevel: gitlab.MaintainerAccess, AccessLevelDescription: "Maintainers"},
+			},
+			CodeOwnerApprovalRequired: true,
+		}, branch)
+	})
+
+	t.Run("error on protecting branch", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expErr)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		branch, err := client.ProtectedBranches().Protect(context.Background(), 10, gitlab.ProtectBranchOptions{Name: "master"})
+		assert.True(t, errors.Is(err, expErr))
+		assert.Equal(t, gitlab.ProtectedBranch{}, branch)
+	})
+}
+
+func TestClient_UpdateProtectedBranch(t *testing.T) {
+	var (
+		baseUrl  = "http://gitlab.test.com/api/v4"
+		required = false
+	)
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodPatch, req.Method)
+		assert.Equal(t, baseUrl+"/projects/10/protected_branches/release-%2A", req.URL.String())
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"code_owner_approval_required": false}`, string(body))
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"name": "release-*"}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	branch, err := client.ProtectedBranches().Update(context.Background(), 10, "release-*", gitlab.UpdateProtectedBranchOptions{
+		CodeOwnerApprovalRequired: &required,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "release-*", branch.Name)
+}
+
+func TestClient_UnprotectBranch(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodDelete, req.Method)
+		assert.Equal(t, baseUrl+"/projects/10/protected_branches/master", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		StatusCode: http.StatusNoContent,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	assert.NoError(t, client.ProtectedBranches().Unprotect(context.Background(), 10, "master"))
+}
+
+func TestClient_ListProtectedTags(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, baseUrl+"/projects/10/protected_tags?per_page=100", req.URL.String())
+	}).Return(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewReader([]byte(
+			`[{"name": "v*", "create_access_levels": [{"access_level": 40}]}]`,
+		))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	tags, err := client.ProtectedTags().List(context.Background(), 10, gitlab.ListOptions{PerPage: 100})
+	assert.NoError(t, err)
+	assert.Equal(t, []gitlab.ProtectedTag{{
+		Name:               "v*",
+		CreateAccessLevels: []gitlab.ProtectedAccessLevel{{AccessLevel: gitlab.MaintainerAccess}},
+	}}, tags)
+}
+
+func TestClient_ProtectTag(t *testing.T) {
+	var (
+		baseUrl    = "http://gitlab.test.com/api/v4"
+		maintainer = gitlab.MaintainerAccess
+	)
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, baseUrl+"/projects/10/protected_tags", req.URL.String())
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name": "v*", "create_access_level": 40}`, string(body))
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"name": "v*"}`))),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	tag, err := client.ProtectedTags().Protect(context.Background(), 10, gitlab.ProtectTagOptions{
+		Name:              "v*",
+		CreateAccessLevel: &maintainer,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, gitlab.ProtectedTag{Name: "v*"}, tag)
+}
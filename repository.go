@@ -0,0 +1,206 @@
+// Package gitlab - repository
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ArchiveFormat is a format of repository archive
+type ArchiveFormat string
+
+// Archive formats
+const (
+	ArchiveTarGz  ArchiveFormat = "tar.gz"
+	ArchiveTarBz2 ArchiveFormat = "tar.bz2"
+	ArchiveTar    ArchiveFormat = "tar"
+	ArchiveZip    ArchiveFormat = "zip"
+)
+
+type (
+	// TreeNode (file or directory of repository tree) entity
+	TreeNode struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+		Path string `json:"path"`
+		Mode string `json:"mode"`
+	}
+
+	// Comparison (result of comparing two refs) entity
+	Comparison struct {
+		Commit         *Commit  `json:"commit"`
+		Commits        []Commit `json:"commits"`
+		Diffs          []Diff   `json:"diffs"`
+		CompareTimeout bool     `json:"compare_timeout"`
+		CompareSameRef bool     `json:"compare_same_ref"`
+		WebUrl         string   `json:"web_url"`
+	}
+
+	// Contributor entity
+	Contributor struct {
+		Name      string `json:"name"`
+		Email     string `json:"email"`
+		Commits   int    `json:"commits"`
+		Additions int    `json:"additions"`
+		Deletions int    `json:"deletions"`
+	}
+
+	// ListTreeOptions contains parameters of repository tree request
+	ListTreeOptions struct {
+		ListOptions
+
+		// Path inside repository, root directory by default
+		Path string
+		// Ref is a branch, tag or commit, default branch by default
+		Ref string
+		// Recursive returns nodes of all nested directories
+		Recursive bool
+		// Keyset enables keyset pagination (gitlab 12.7 or later), Page is ignored then
+		Keyset bool
+		// PageToken is id of the node the page starts after, it is used with keyset pagination only
+		PageToken string
+	}
+
+	// ListContributorsOptions contains parameters of contributors list request
+	ListContributorsOptions struct {
+		ListOptions
+
+		// OrderBy is one of "name", "email" or "commits"
+		OrderBy string
+		// Sort is one of "asc" or "desc"
+		Sort string
+	}
+
+	// ArchiveOptions contains parameters of repository archive request
+	ArchiveOptions struct {
+		// Format of archive, tar.gz by default
+		Format ArchiveFormat
+		// SHA is a commit, branch or tag to download, default branch by default
+		SHA string
+		// Path is a subpath of repository to download
+		Path string
+	}
+)
+
+func (opts ListTreeOptions) values() url.Values {
+	values := opts.ListOptions.values()
+	if opts.Path != "" {
+		values.Set("path", opts.Path)
+	}
+
+	if opts.Ref != "" {
+		values.Set("ref", opts.Ref)
+	}
+
+	if opts.Recursive {
+		values.Set("recursive", strconv.FormatBool(opts.Recursive))
+	}
+
+	if opts.Keyset {
+		values.Del("page")
+		values.Set("pagination", "keyset")
+		if opts.PageToken != "" {
+			values.Set("page_token", opts.PageToken)
+		}
+	}
+
+	return values
+}
+
+func (opts ListContributorsOptions) values() url.Values {
+	values := opts.ListOptions.values()
+	if opts.OrderBy != "" {
+		values.Set("order_by", opts.OrderBy)
+	}
+
+	if opts.Sort != "" {
+		values.Set("sort", opts.Sort)
+	}
+
+	return values
+}
+
+func (opts ArchiveOptions) values() url.Values {
+	values := url.Values{}
+	if opts.SHA != "" {
+		values.Set("sha", opts.SHA)
+	}
+
+	if opts.Path != "" {
+		values.Set("path", opts.Path)
+	}
+
+	return values
+}
+
+func listTree(ctx context.Context, c *client, projectID int, opts ListTreeOptions) ([]TreeNode, error) {
+	if opts.Keyset {
+		if err := c.requireFeature(ctx, FeatureKeysetPagination); err != nil {
+			return nil, err
+		}
+	}
+
+	path := fmt.Sprintf("projects/%d/repository/tree", projectID)
+	resp, err := c.get(ctx, withQuery(path, opts.values()))
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []TreeNode
+	if err = json.Unmarshal(resp, &nodes); err != nil {
+		return nil, fmt.Errorf("can't unmarshal repository tree data: %w", err)
+	}
+
+	return nodes, nil
+}
+
+func compare(ctx context.Context, c *client, projectID int, from, to string) (Comparison, error) {
+	values := url.Values{}
+	values.Set("from", from)
+	values.Set("to", to)
+
+	path := fmt.Sprintf("projects/%d/repository/compare", projectID)
+	resp, err := c.get(ctx, withQuery(path, values))
+	if err != nil {
+		return Comparison{}, err
+	}
+
+	var comparison Comparison
+	if err = json.Unmarshal(resp, &comparison); err != nil {
+		return Comparison{}, fmt.Errorf("can't unmarshal comparison data: %w", err)
+	}
+
+	return comparison, nil
+}
+
+func listContributors(ctx context.Context, c *client, projectID int, opts ListContributorsOptions) ([]Contributor, error) {
+	path := fmt.Sprintf("projects/%d/repository/contributors", projectID)
+	resp, err := c.get(ctx, withQuery(path, opts.values()))
+	if err != nil {
+		return nil, err
+	}
+
+	var contributors []Contributor
+	if err = json.Unmarshal(resp, &contributors); err != nil {
+		return nil, fmt.Errorf("can't unmarshal contributors data: %w", err)
+	}
+
+	return contributors, nil
+}
+
+func getArchive(ctx context.Context, c *client, projectID int, w io.Writer, opts ArchiveOptions) error {
+	format := opts.Format
+	if format == "" {
+		format = ArchiveTarGz
+	}
+
+	path := fmt.Sprintf("projects/%d/repository/archive.%s", projectID, format)
+
+	return c.stream(ctx, http.MethodGet, withQuery(path, opts.values()), w)
+}
@@ -0,0 +1,256 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestClient_ListTree(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/projects/10/repository/tree?page=3&path=docs&recursive=true&ref=develop", req.URL.String())
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(
+				`[{"id": "a1", "name": "api", "type": "tree", "path": "docs/api", "mode": "040000"}]`,
+			))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		nodes, err := client.Repository().ListTree(context.Background(), 10, gitlab.ListTreeOptions{
+			ListOptions: gitlab.ListOptions{Page: 3},
+			Path:        "docs",
+			Ref:         "develop",
+			Recursive:   true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []gitlab.TreeNode{{ID: "a1", Name: "api", Type: "tree", Path: "docs/api", Mode: "040000"}}, nodes)
+	})
+
+	t.Run("keyset pagination", func(t *testing.T) {
+		httpClient := newVersionHTTPClient("12.7.0")
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, "page_token=a1&pagination=keyset&per_page=20", req.URL.RawQuery)
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`[{"id": "b2", "name": "guide"}]`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		client := gitlab.NewClient("test_token", gitlab.WithHttpClient(httpClient))
+
+		nodes, err := client.Repository().ListTree(context.Background(), 10, gitlab.ListTreeOptions{
+			ListOptions: gitlab.ListOptions{Page: 2, PerPage: 20},
+			Keyset:      true,
+			PageToken:   "a1",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []gitlab.TreeNode{{ID: "b2", Name: "guide"}}, nodes)
+		httpClient.AssertExpectations(t)
+	})
+
+	t.Run("keyset pagination unsupported by version", func(t *testing.T) {
+		httpClient := newVersionHTTPClient("12.6.0")
+		client := gitlab.NewClient("test_token", gitlab.WithHttpClient(httpClient))
+
+		nodes, err := client.Repository().ListTree(context.Background(), 10, gitlab.ListTreeOptions{Keyset: true})
+		assert.True(t, errors.Is(err, gitlab.ErrUnsupported))
+		assert.EqualError(t, err, "keyset_pagination requires gitlab 12.7, server version is 12.6.0")
+		assert.Nil(t, nodes)
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("error on getting tree", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expErr)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		nodes, err := client.Repository().ListTree(context.Background(), 10, gitlab.ListTreeOptions{})
+		assert.True(t, errors.Is(err, expErr))
+		assert.Equal(t, []gitlab.TreeNode(nil), nodes)
+	})
+}
+
+func TestClient_Compare(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/projects/10/repository/compare?from=master&to=feature", req.URL.String())
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(`{
+				"commit": {"id": "b2"},
+				"commits": [{"id": "a1"}, {"id": "b2"}],
+				"diffs": [{"old_path": "a.go", "new_path": "a.go", "diff": "@@ -1 +1 @@\n-a\n+b\n"}]
+			}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		comparison, err := client.Repository().Compare(context.Background(), 10, "master", "feature")
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.Comparison{
+			Commit:  &gitlab.Commit{ID: "b2"},
+			Commits: []gitlab.Commit{{ID: "a1"}, {ID: "b2"}},
+			Diffs:   []gitlab.Diff{{OldPath: "a.go", NewPath: "a.go", Diff: "@@ -1 +1 @@\n-a\n+b\n"}},
+		}, comparison)
+	})
+
+	t.Run("error on unmarshal response", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{"))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		comparison, err := client.Repository().Compare(context.Background(), 10, "master", "feature")
+		assert.Error(t, err)
+		assert.Equal(t, gitlab.Comparison{}, comparison)
+	})
+}
+
+func TestClient_ListContributors(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, baseUrl+"/projects/10/repository/contributors?order_by=commits&sort=desc", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`[{"name": "John", "email": "john@test.com", "commits": 7}]`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	contributors, err := client.Repository().ListContributors(context.Background(), 10, gitlab.ListContributorsOptions{
+		OrderBy: "commits",
+		Sort:    "desc",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []gitlab.Contributor{{Name: "John", Email: "john@test.com", Commits: 7}}, contributors)
+}
+
+func TestClient_GetArchive(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		var (
+			baseUrl    = "http://gitlab.test.com/api/v4"
+			expArchive = []byte("archive content")
+		)
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/projects/10/repository/archive.zip?path=docs&sha=v1.0.0", req.URL.String())
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader(expArchive)),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		var buf bytes.Buffer
+		err := client.Repository().GetArchive(context.Background(), 10, &buf, gitlab.ArchiveOptions{
+			Format: gitlab.ArchiveZip,
+			SHA:    "v1.0.0",
+			Path:   "docs",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, expArchive, buf.Bytes())
+	})
+
+	t.Run("default format", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/projects/10/repository/archive.tar.gz", req.URL.String())
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		assert.NoError(t, client.Repository().GetArchive(context.Background(), 10, ioutil.Discard, gitlab.ArchiveOptions{}))
+	})
+
+	t.Run("non 200 status", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+			StatusCode: http.StatusNotFound,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		var buf bytes.Buffer
+		err := client.Repository().GetArchive(context.Background(), 10, &buf, gitlab.ArchiveOptions{})
+		assert.Error(t, err)
+		assert.Equal(t, 0, buf.Len())
+	})
+}
@@ -0,0 +1,100 @@
+// Package gitlab - request options
+package gitlab
+
+import (
+	"context"
+	"net/http"
+)
+
+const sudoKey = "Sudo"
+
+type (
+	// RequestOption to use optional parameters of single request
+	RequestOption interface {
+		apply(req *http.Request)
+	}
+
+	withSudo struct {
+		user string
+	}
+
+	withHeader struct {
+		key, value string
+	}
+
+	withRequestToken struct {
+		token string
+	}
+
+	withQueryParam struct {
+		key, value string
+	}
+
+	requestOptionsKey struct{}
+)
+
+// WithSudo performs request as another user by user name or id, it requires admin token with sudo scope
+func WithSudo(userOrID string) RequestOption {
+	return withSudo{user: userOrID}
+}
+
+func (opt withSudo) apply(req *http.Request) {
+	req.Header.Set(sudoKey, opt.user)
+}
+
+// WithHeader sets header of request
+func WithHeader(key, value string) RequestOption {
+	return withHeader{key: key, value: value}
+}
+
+func (opt withHeader) apply(req *http.Request) {
+	req.Header.Set(opt.key, opt.value)
+}
+
+// WithRequestToken authenticates request by access token instead of client one,
+// credentials set by client, context or previous options are removed
+func WithRequestToken(token string) RequestOption {
+	return withRequestToken{token: token}
+}
+
+func (opt withRequestToken) apply(req *http.Request) {
+	for _, key := range []string{authorizationKey, jobTokenKey, privateTokenKey} {
+		req.Header.Del(key)
+	}
+
+	req.Header.Set(privateTokenKey, opt.token)
+}
+
+// WithQueryParam sets query parameter of request replacing value set by method
+func WithQueryParam(key, value string) RequestOption {
+	return withQueryParam{key: key, value: value}
+}
+
+func (opt withQueryParam) apply(req *http.Request) {
+	query := req.URL.Query()
+	query.Set(opt.key, opt.value)
+	req.URL.RawQuery = query.Encode()
+}
+
+// ContextWithRequestOptions returns context applying options to every request made with it,
+// e.g. to act on behalf of user by WithSudo or WithRequestToken in all calls of http handler
+func ContextWithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	return withRequestOptions(ctx, opts)
+}
+
+// withRequestOptions adds options to ones of context, they are applied after options of context
+func withRequestOptions(ctx context.Context, opts []RequestOption) context.Context {
+	if len(opts) == 0 {
+		return ctx
+	}
+
+	parent := requestOptionsFromContext(ctx)
+	merged := make([]RequestOption, 0, len(parent)+len(opts))
+
+	return context.WithValue(ctx, requestOptionsKey{}, append(append(merged, parent...), opts...))
+}
+
+func requestOptionsFromContext(ctx context.Context) []RequestOption {
+	opts, _ := ctx.Value(requestOptionsKey{}).([]RequestOption)
+	return opts
+}
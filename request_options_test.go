@@ -0,0 +1,111 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestClient_RequestOptions(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []*http.Request
+	)
+
+	newClient := func(body string, opts ...gitlab.ClientOption) gitlab.Client {
+		requests = nil
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).
+			Run(func(args mock.Arguments) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				requests = append(requests, args.Get(0).(*http.Request))
+			}).
+			Return(func(*http.Request) *http.Response {
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+					StatusCode: http.StatusOK,
+				}
+			}, nil)
+
+		return gitlab.NewClient("test_token", append(opts, gitlab.WithHttpClient(httpClient))...)
+	}
+
+	t.Run("sudo and headers", func(t *testing.T) {
+		client := newClient(`[{"id": 5}]`)
+
+		_, err := client.MergeRequests().List(
+			context.Background(),
+			10,
+			gitlab.ListMergeRequestsOptions{State: "opened"},
+			gitlab.WithSudo("john"),
+			gitlab.WithHeader("X-Request-Id", "abc"),
+			gitlab.WithQueryParam("state", "all"),
+		)
+		assert.NoError(t, err)
+
+		assert.Len(t, requests, 1)
+		assert.Equal(t, "john", requests[0].Header.Get("Sudo"))
+		assert.Equal(t, "abc", requests[0].Header.Get("X-Request-Id"))
+		assert.Equal(t, "test_token", requests[0].Header.Get("Private-Token"))
+		assert.Equal(t, "state=all", requests[0].URL.RawQuery)
+	})
+
+	t.Run("request token", func(t *testing.T) {
+		client := newClient(`{}`, gitlab.WithJobToken("job_token"))
+
+		ctx := gitlab.ContextWithRequestOptions(
+			context.Background(),
+			gitlab.WithHeader("Authorization", "Bearer oauth_token"),
+			gitlab.WithHeader("Private-Token", "context_token"),
+		)
+		_, err := client.SendRequest(ctx, http.MethodGet, "version", nil, gitlab.WithRequestToken("user_token"))
+		assert.NoError(t, err)
+
+		assert.Len(t, requests, 1)
+		assert.Equal(t, []string{"user_token"}, requests[0].Header.Values("Private-Token"))
+		assert.Empty(t, requests[0].Header.Values("Job-Token"))
+		assert.Empty(t, requests[0].Header.Values("Authorization"))
+	})
+
+	t.Run("context options", func(t *testing.T) {
+		client := newClient(`{"id": 5}`)
+
+		ctx := gitlab.ContextWithRequestOptions(context.Background(), gitlab.WithSudo("john"), gitlab.WithHeader("X-A", "a"))
+
+		_, err := client.GetUsersByIDs(ctx, []int{5, 10}, gitlab.WithSudo("jane"))
+		assert.NoError(t, err)
+
+		assert.Len(t, requests, 2)
+		for _, req := range requests {
+			assert.Equal(t, "jane", req.Header.Get("Sudo"))
+			assert.Equal(t, "a", req.Header.Get("X-A"))
+		}
+
+		_, err = client.GetUserByID(ctx, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, "john", requests[2].Header.Get("Sudo"))
+	})
+
+	t.Run("cache per sudo user", func(t *testing.T) {
+		client := newClient(`{"id": 5}`, gitlab.WithCache(nil, gitlab.CacheOptions{TTL: time.Minute}))
+
+		for _, user := range []string{"john", "jane", "john"} {
+			_, err := client.MergeRequests().Get(context.Background(), 10, 20, gitlab.WithSudo(user))
+			assert.NoError(t, err)
+		}
+
+		assert.Len(t, requests, 2)
+	})
+}
@@ -0,0 +1,1143 @@
+// Package gitlab - services
+package gitlab
+
+import (
+	"context"
+	"io"
+)
+
+type (
+	// Users provides api to work with users
+	Users interface {
+		// GetByIDs returns list of users by ids
+		GetByIDs(ctx context.Context, ids []int, reqOpts ...RequestOption) ([]User, error)
+
+		// GetByID returns single user by id
+		GetByID(ctx context.Context, userID int, reqOpts ...RequestOption) (User, error)
+	}
+
+	// Discussions provides api to work with merge request discussions
+	Discussions interface {
+		// Get returns discussion data by project id, merge request id and discussion id
+		Get(ctx context.Context, projectID, mrID int, discussionID string, reqOpts ...RequestOption) (Discussion, error)
+
+		// List returns list of merge request discussions
+		List(ctx context.Context, projectID, mrID int, opts ListOptions, reqOpts ...RequestOption) ([]Discussion, error)
+
+		// GetParticipants returns all participants from discussion (by project id, merge request id and discussion id)
+		GetParticipants(
+			ctx context.Context,
+			projectID, mrID int,
+			discussionID string,
+			reqOpts ...RequestOption,
+		) ([]NoteAuthor, error)
+	}
+
+	// MergeRequests provides api to work with merge requests, their diffs and approvals
+	MergeRequests interface {
+		// List returns list of project merge requests
+		List(
+			ctx context.Context,
+			projectID int,
+			opts ListMergeRequestsOptions,
+			reqOpts ...RequestOption,
+		) ([]MergeRequest, error)
+
+		// Get returns single merge request by project id and merge request id
+		Get(ctx context.Context, projectID, mrID int, reqOpts ...RequestOption) (MergeRequest, error)
+
+		// Create creates merge request
+		Create(
+			ctx context.Context,
+			projectID int,
+			opts CreateMergeRequestOptions,
+			reqOpts ...RequestOption,
+		) (MergeRequest, error)
+
+		// Update updates merge request
+		Update(
+			ctx context.Context,
+			projectID, mrID int,
+			opts UpdateMergeRequestOptions,
+			reqOpts ...RequestOption,
+		) (MergeRequest, error)
+
+		// GetChanges returns merge request with diffs of all changed files
+		GetChanges(ctx context.Context, projectID, mrID int, reqOpts ...RequestOption) (MergeRequestChanges, error)
+
+		// ListDiffs returns diffs of files changed in merge request
+		ListDiffs(ctx context.Context, projectID, mrID int, opts ListOptions, reqOpts ...RequestOption) ([]Diff, error)
+
+		// ListDiffVersions returns list of merge request diff versions (latest first)
+		ListDiffVersions(
+			ctx context.Context,
+			projectID, mrID int,
+			reqOpts ...RequestOption,
+		) ([]MergeRequestDiffVersion, error)
+
+		// GetDiffVersion returns single merge request diff version with commits and diffs
+		GetDiffVersion(
+			ctx context.Context,
+			projectID, mrID, versionID int,
+			reqOpts ...RequestOption,
+		) (MergeRequestDiffVersion, error)
+
+		// GetParticipants returns all participants of merge request (from all its discussions)
+		// with their roles and activity
+		GetParticipants(
+			ctx context.Context,
+			projectID, mrID int,
+			opts ParticipantsReportOptions,
+			reqOpts ...RequestOption,
+		) ([]Participant, error)
+
+		// BuildPosition returns position of text diff note by file path and line of latest merge request diff version
+		BuildPosition(
+			ctx context.Context,
+			projectID, mrID int,
+			opts BuildPositionOptions,
+			reqOpts ...RequestOption,
+		) (Position, error)
+
+		// BuildImagePosition returns position of image diff note by file path of latest merge request diff version
+		BuildImagePosition(
+			ctx context.Context,
+			projectID, mrID int,
+			opts BuildImagePositionOptions,
+			reqOpts ...RequestOption,
+		) (Position, error)
+
+		// Approve approves merge request, sha (optional) must match merge request head
+		Approve(ctx context.Context, projectID, mrID int, sha string, reqOpts ...RequestOption) (MergeRequestApprovals, error)
+
+		// Unapprove removes approval of current user from merge request
+		Unapprove(ctx context.Context, projectID, mrID int, reqOpts ...RequestOption) error
+
+		// GetApprovals returns merge request approvals
+		GetApprovals(ctx context.Context, projectID, mrID int, reqOpts ...RequestOption) (MergeRequestApprovals, error)
+
+		// GetApprovalState returns merge request approval rules with their approval status
+		GetApprovalState(ctx context.Context, projectID, mrID int, reqOpts ...RequestOption) (ApprovalState, error)
+
+		// ListApprovalRules returns list of merge request level approval rules
+		ListApprovalRules(ctx context.Context, projectID, mrID int, reqOpts ...RequestOption) ([]ApprovalRule, error)
+
+		// CreateApprovalRule creates merge request level approval rule
+		CreateApprovalRule(
+			ctx context.Context,
+			projectID, mrID int,
+			opts ApprovalRuleOptions,
+			reqOpts ...RequestOption,
+		) (ApprovalRule, error)
+
+		// UpdateApprovalRule updates merge request level approval rule
+		UpdateApprovalRule(
+			ctx context.Context,
+			projectID, mrID, ruleID int,
+			opts UpdateApprovalRuleOptions,
+			reqOpts ...RequestOption,
+		) (ApprovalRule, error)
+
+		// DeleteApprovalRule deletes merge request level approval rule
+		DeleteApprovalRule(ctx context.Context, projectID, mrID, ruleID int, reqOpts ...RequestOption) error
+	}
+
+	// Projects provides api to work with project approval rules and hooks
+	Projects interface {
+		// ListApprovalRules returns list of project level approval rules
+		ListApprovalRules(ctx context.Context, projectID int, reqOpts ...RequestOption) ([]ApprovalRule, error)
+
+		// CreateApprovalRule creates project level approval rule
+		CreateApprovalRule(
+			ctx context.Context,
+			projectID int,
+			opts ApprovalRuleOptions,
+			reqOpts ...RequestOption,
+		) (ApprovalRule, error)
+
+		// UpdateApprovalRule updates project level approval rule
+		UpdateApprovalRule(
+			ctx context.Context,
+			projectID, ruleID int,
+			opts UpdateApprovalRuleOptions,
+			reqOpts ...RequestOption,
+		) (ApprovalRule, error)
+
+		// DeleteApprovalRule deletes project level approval rule
+		DeleteApprovalRule(ctx context.Context, projectID, ruleID int, reqOpts ...RequestOption) error
+
+		// ListHooks returns list of project hooks
+		ListHooks(ctx context.Context, projectID int, opts ListOptions, reqOpts ...RequestOption) ([]Hook, error)
+
+		// GetHook returns single project hook by id
+		GetHook(ctx context.Context, projectID, hookID int, reqOpts ...RequestOption) (Hook, error)
+
+		// AddHook creates project hook
+		AddHook(ctx context.Context, projectID int, opts HookOptions, reqOpts ...RequestOption) (Hook, error)
+
+		// EditHook edits project hook
+		EditHook(ctx context.Context, projectID, hookID int, opts HookOptions, reqOpts ...RequestOption) (Hook, error)
+
+		// DeleteHook deletes project hook
+		DeleteHook(ctx context.Context, projectID, hookID int, reqOpts ...RequestOption) error
+
+		// TestHook triggers test event of project hook
+		TestHook(ctx context.Context, projectID, hookID int, trigger HookTrigger, reqOpts ...RequestOption) error
+
+		// SetHookUrlVariable creates or updates url variable of project hook
+		SetHookUrlVariable(ctx context.Context, projectID, hookID int, key, value string, reqOpts ...RequestOption) error
+
+		// DeleteHookUrlVariable deletes url variable of project hook
+		DeleteHookUrlVariable(ctx context.Context, projectID, hookID int, key string, reqOpts ...RequestOption) error
+	}
+
+	// Groups provides api to work with group hooks
+	Groups interface {
+		// ListHooks returns list of group hooks
+		ListHooks(ctx context.Context, groupID int, opts ListOptions, reqOpts ...RequestOption) ([]Hook, error)
+
+		// GetHook returns single group hook by id
+		GetHook(ctx context.Context, groupID, hookID int, reqOpts ...RequestOption) (Hook, error)
+
+		// AddHook creates group hook
+		AddHook(ctx context.Context, groupID int, opts HookOptions, reqOpts ...RequestOption) (Hook, error)
+
+		// EditHook edits group hook
+		EditHook(ctx context.Context, groupID, hookID int, opts HookOptions, reqOpts ...RequestOption) (Hook, error)
+
+		// DeleteHook deletes group hook
+		DeleteHook(ctx context.Context, groupID, hookID int, reqOpts ...RequestOption) error
+
+		// TestHook triggers test event of group hook
+		TestHook(ctx context.Context, groupID, hookID int, trigger HookTrigger, reqOpts ...RequestOption) error
+
+		// SetHookUrlVariable creates or updates url variable of group hook
+		SetHookUrlVariable(ctx context.Context, groupID, hookID int, key, value string, reqOpts ...RequestOption) error
+
+		// DeleteHookUrlVariable deletes url variable of group hook
+		DeleteHookUrlVariable(ctx context.Context, groupID, hookID int, key string, reqOpts ...RequestOption) error
+	}
+
+	// SystemHooks provides api to work with system hooks
+	SystemHooks interface {
+		// List returns list of system hooks (admin only)
+		List(ctx context.Context, opts ListOptions, reqOpts ...RequestOption) ([]SystemHook, error)
+
+		// Add creates system hook (admin only)
+		Add(ctx context.Context, opts SystemHookOptions, reqOpts ...RequestOption) (SystemHook, error)
+
+		// Test triggers test event of system hook (admin only)
+		Test(ctx context.Context, hookID int, reqOpts ...RequestOption) error
+
+		// Delete deletes system hook (admin only)
+		Delete(ctx context.Context, hookID int, reqOpts ...RequestOption) error
+	}
+
+	// Branches provides api to work with repository branches
+	Branches interface {
+		// List returns list of repository branches by project id
+		List(ctx context.Context, projectID int, opts ListBranchesOptions, reqOpts ...RequestOption) ([]Branch, error)
+
+		// Get returns single repository branch by project id and branch name
+		Get(ctx context.Context, projectID int, branch string, reqOpts ...RequestOption) (Branch, error)
+
+		// Create creates new branch from ref (branch name or commit sha)
+		Create(ctx context.Context, projectID int, branch, ref string, reqOpts ...RequestOption) (Branch, error)
+
+		// Delete deletes repository branch by project id and branch name
+		Delete(ctx context.Context, projectID int, branch string, reqOpts ...RequestOption) error
+
+		// DeleteMerged deletes all branches merged into the project's default branch
+		DeleteMerged(ctx context.Context, projectID int, reqOpts ...RequestOption) error
+	}
+
+	// Tags provides api to work with repository tags
+	Tags interface {
+		// List returns list of repository tags by project id
+		List(ctx context.Context, projectID int, opts ListTagsOptions, reqOpts ...RequestOption) ([]Tag, error)
+
+		// Get returns single repository tag by project id and tag name
+		Get(ctx context.Context, projectID int, tag string, reqOpts ...RequestOption) (Tag, error)
+
+		// Create creates new tag (with optional release notes)
+		Create(ctx context.Context, projectID int, opts CreateTagOptions, reqOpts ...RequestOption) (Tag, error)
+
+		// Delete deletes repository tag by project id and tag name
+		Delete(ctx context.Context, projectID int, tag string, reqOpts ...RequestOption) error
+	}
+
+	// ProtectedBranches provides api to work with protected branches
+	ProtectedBranches interface {
+		// List returns list of protected branches by project id
+		List(ctx context.Context, projectID int, opts ListOptions, reqOpts ...RequestOption) ([]ProtectedBranch, error)
+
+		// Get returns single protected branch by project id and branch name (or wildcard)
+		Get(ctx context.Context, projectID int, name string, reqOpts ...RequestOption) (ProtectedBranch, error)
+
+		// Protect protects branch (or branches matching wildcard)
+		Protect(
+			ctx context.Context,
+			projectID int,
+			opts ProtectBranchOptions,
+			reqOpts ...RequestOption,
+		) (ProtectedBranch, error)
+
+		// Update updates force push and code owner approval flags of protected branch
+		Update(
+			ctx context.Context,
+			projectID int,
+			name string,
+			opts UpdateProtectedBranchOptions,
+			reqOpts ...RequestOption,
+		) (ProtectedBranch, error)
+
+		// Unprotect removes protection from branch (or wildcard)
+		Unprotect(ctx context.Context, projectID int, name string, reqOpts ...RequestOption) error
+	}
+
+	// ProtectedTags provides api to work with protected tags
+	ProtectedTags interface {
+		// List returns list of protected tags by project id
+		List(ctx context.Context, projectID int, opts ListOptions, reqOpts ...RequestOption) ([]ProtectedTag, error)
+
+		// Get returns single protected tag by project id and tag name (or wildcard)
+		Get(ctx context.Context, projectID int, name string, reqOpts ...RequestOption) (ProtectedTag, error)
+
+		// Protect protects tag (or tags matching wildcard)
+		Protect(ctx context.Context, projectID int, opts ProtectTagOptions, reqOpts ...RequestOption) (ProtectedTag, error)
+
+		// Unprotect removes protection from tag (or wildcard)
+		Unprotect(ctx context.Context, projectID int, name string, reqOpts ...RequestOption) error
+	}
+
+	// Repository provides api to work with repository tree, comparisons, contributors and archives
+	Repository interface {
+		// ListTree returns list of files and directories of repository tree
+		ListTree(ctx context.Context, projectID int, opts ListTreeOptions, reqOpts ...RequestOption) ([]TreeNode, error)
+
+		// Compare returns commits and diffs between two refs (branches, tags or commits)
+		Compare(ctx context.Context, projectID int, from, to string, reqOpts ...RequestOption) (Comparison, error)
+
+		// ListContributors returns list of repository contributors
+		ListContributors(
+			ctx context.Context,
+			projectID int,
+			opts ListContributorsOptions,
+			reqOpts ...RequestOption,
+		) ([]Contributor, error)
+
+		// GetArchive writes archive of repository ref (or its subpath) to w
+		GetArchive(ctx context.Context, projectID int, w io.Writer, opts ArchiveOptions, reqOpts ...RequestOption) error
+	}
+
+	// AwardEmojis provides api to work with award emoji of merge requests, issues, snippets and notes
+	AwardEmojis interface {
+		// List returns list of emoji reactions awarded to merge request, issue, snippet or note
+		List(ctx context.Context, awardable Awardable, opts ListOptions, reqOpts ...RequestOption) ([]AwardEmoji, error)
+
+		// Add awards emoji (by its name, e.g. "thumbsup") to merge request, issue, snippet or note
+		Add(ctx context.Context, awardable Awardable, name string, reqOpts ...RequestOption) (AwardEmoji, error)
+
+		// Remove removes emoji reaction by its id
+		Remove(ctx context.Context, awardable Awardable, awardID int, reqOpts ...RequestOption) error
+	}
+
+	// Instance provides api to get version, metadata and features of gitlab instance
+	Instance interface {
+		// Version returns version of gitlab instance
+		Version(ctx context.Context, reqOpts ...RequestOption) (Version, error)
+
+		// Metadata returns version and configuration of gitlab instance (gitlab 15.2 or later)
+		Metadata(ctx context.Context, reqOpts ...RequestOption) (Metadata, error)
+
+		// SupportsFeature reports whether feature is supported by gitlab version, version is requested once
+		SupportsFeature(ctx context.Context, feature Feature, reqOpts ...RequestOption) (bool, error)
+	}
+
+	usersService struct {
+		c *client
+	}
+
+	discussionsService struct {
+		c *client
+	}
+
+	mergeRequestsService struct {
+		c *client
+	}
+
+	projectsService struct {
+		c *client
+	}
+
+	groupsService struct {
+		c *client
+	}
+
+	systemHooksService struct {
+		c *client
+	}
+
+	branchesService struct {
+		c *client
+	}
+
+	tagsService struct {
+		c *client
+	}
+
+	protectedBranchesService struct {
+		c *client
+	}
+
+	protectedTagsService struct {
+		c *client
+	}
+
+	repositoryService struct {
+		c *client
+	}
+
+	awardEmojisService struct {
+		c *client
+	}
+
+	instanceService struct {
+		c *client
+	}
+)
+
+// Users implementation
+func (c *client) Users() Users {
+	return usersService{c: c}
+}
+
+// Discussions implementation
+func (c *client) Discussions() Discussions {
+	return discussionsService{c: c}
+}
+
+// MergeRequests implementation
+func (c *client) MergeRequests() MergeRequests {
+	return mergeRequestsService{c: c}
+}
+
+// Projects implementation
+func (c *client) Projects() Projects {
+	return projectsService{c: c}
+}
+
+// Groups implementation
+func (c *client) Groups() Groups {
+	return groupsService{c: c}
+}
+
+// SystemHooks implementation
+func (c *client) SystemHooks() SystemHooks {
+	return systemHooksService{c: c}
+}
+
+// Branches implementation
+func (c *client) Branches() Branches {
+	return branchesService{c: c}
+}
+
+// Tags implementation
+func (c *client) Tags() Tags {
+	return tagsService{c: c}
+}
+
+// ProtectedBranches implementation
+func (c *client) ProtectedBranches() ProtectedBranches {
+	return protectedBranchesService{c: c}
+}
+
+// ProtectedTags implementation
+func (c *client) ProtectedTags() ProtectedTags {
+	return protectedTagsService{c: c}
+}
+
+// Repository implementation
+func (c *client) Repository() Repository {
+	return repositoryService{c: c}
+}
+
+// AwardEmojis implementation
+func (c *client) AwardEmojis() AwardEmojis {
+	return awardEmojisService{c: c}
+}
+
+// Instance implementation
+func (c *client) Instance() Instance {
+	return instanceService{c: c}
+}
+
+// GetByIDs implementation
+func (s usersService) GetByIDs(ctx context.Context, ids []int, reqOpts ...RequestOption) ([]User, error) {
+	return s.c.GetUsersByIDs(ctx, ids, reqOpts...)
+}
+
+// GetByID implementation
+func (s usersService) GetByID(ctx context.Context, userID int, reqOpts ...RequestOption) (User, error) {
+	return s.c.GetUserByID(ctx, userID, reqOpts...)
+}
+
+// Get implementation
+func (s discussionsService) Get(
+	ctx context.Context,
+	projectID, mrID int,
+	discussionID string,
+	reqOpts ...RequestOption,
+) (Discussion, error) {
+	return s.c.GetDiscussion(ctx, projectID, mrID, discussionID, reqOpts...)
+}
+
+// List implementation
+func (s discussionsService) List(
+	ctx context.Context,
+	projectID, mrID int,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) ([]Discussion, error) {
+	return s.c.ListDiscussions(ctx, projectID, mrID, opts, reqOpts...)
+}
+
+// GetParticipants implementation
+func (s discussionsService) GetParticipants(
+	ctx context.Context,
+	projectID, mrID int,
+	discussionID string,
+	reqOpts ...RequestOption,
+) ([]NoteAuthor, error) {
+	return s.c.GetParticipants(ctx, projectID, mrID, discussionID, reqOpts...)
+}
+
+// List implementation
+func (s mergeRequestsService) List(
+	ctx context.Context,
+	projectID int,
+	opts ListMergeRequestsOptions,
+	reqOpts ...RequestOption,
+) ([]MergeRequest, error) {
+	return s.c.ListMergeRequests(ctx, projectID, opts, reqOpts...)
+}
+
+// Get implementation
+func (s mergeRequestsService) Get(
+	ctx context.Context,
+	projectID, mrID int,
+	reqOpts ...RequestOption,
+) (MergeRequest, error) {
+	return s.c.GetMergeRequest(ctx, projectID, mrID, reqOpts...)
+}
+
+// Create implementation
+func (s mergeRequestsService) Create(
+	ctx context.Context,
+	projectID int,
+	opts CreateMergeRequestOptions,
+	reqOpts ...RequestOption,
+) (MergeRequest, error) {
+	return s.c.CreateMergeRequest(ctx, projectID, opts, reqOpts...)
+}
+
+// Update implementation
+func (s mergeRequestsService) Update(
+	ctx context.Context,
+	projectID, mrID int,
+	opts UpdateMergeRequestOptions,
+	reqOpts ...RequestOption,
+) (MergeRequest, error) {
+	return s.c.UpdateMergeRequest(ctx, projectID, mrID, opts, reqOpts...)
+}
+
+// GetChanges implementation
+func (s mergeRequestsService) GetChanges(
+	ctx context.Context,
+	projectID, mrID int,
+	reqOpts ...RequestOption,
+) (MergeRequestChanges, error) {
+	return s.c.GetMergeRequestChanges(ctx, projectID, mrID, reqOpts...)
+}
+
+// ListDiffs implementation
+func (s mergeRequestsService) ListDiffs(
+	ctx context.Context,
+	projectID, mrID int,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) ([]Diff, error) {
+	return s.c.ListMergeRequestDiffs(ctx, projectID, mrID, opts, reqOpts...)
+}
+
+// ListDiffVersions implementation
+func (s mergeRequestsService) ListDiffVersions(
+	ctx context.Context,
+	projectID, mrID int,
+	reqOpts ...RequestOption,
+) ([]MergeRequestDiffVersion, error) {
+	return s.c.ListMergeRequestDiffVersions(ctx, projectID, mrID, reqOpts...)
+}
+
+// GetDiffVersion implementation
+func (s mergeRequestsService) GetDiffVersion(
+	ctx context.Context,
+	projectID, mrID, versionID int,
+	reqOpts ...RequestOption,
+) (MergeRequestDiffVersion, error) {
+	return s.c.GetMergeRequestDiffVersion(ctx, projectID, mrID, versionID, reqOpts...)
+}
+
+// GetParticipants implementation
+func (s mergeRequestsService) GetParticipants(
+	ctx context.Context,
+	projectID, mrID int,
+	opts ParticipantsReportOptions,
+	reqOpts ...RequestOption,
+) ([]Participant, error) {
+	return s.c.GetMergeRequestParticipants(ctx, projectID, mrID, opts, reqOpts...)
+}
+
+// BuildPosition implementation
+func (s mergeRequestsService) BuildPosition(
+	ctx context.Context,
+	projectID, mrID int,
+	opts BuildPositionOptions,
+	reqOpts ...RequestOption,
+) (Position, error) {
+	return s.c.BuildPosition(ctx, projectID, mrID, opts, reqOpts...)
+}
+
+// BuildImagePosition implementation
+func (s mergeRequestsService) BuildImagePosition(
+	ctx context.Context,
+	projectID, mrID int,
+	opts BuildImagePositionOptions,
+	reqOpts ...RequestOption,
+) (Position, error) {
+	return s.c.BuildImagePosition(ctx, projectID, mrID, opts, reqOpts...)
+}
+
+// Approve implementation
+func (s mergeRequestsService) Approve(
+	ctx context.Context,
+	projectID, mrID int,
+	sha string,
+	reqOpts ...RequestOption,
+) (MergeRequestApprovals, error) {
+	return s.c.ApproveMergeRequest(ctx, projectID, mrID, sha, reqOpts...)
+}
+
+// Unapprove implementation
+func (s mergeRequestsService) Unapprove(ctx context.Context, projectID, mrID int, reqOpts ...RequestOption) error {
+	return s.c.UnapproveMergeRequest(ctx, projectID, mrID, reqOpts...)
+}
+
+// GetApprovals implementation
+func (s mergeRequestsService) GetApprovals(
+	ctx context.Context,
+	projectID, mrID int,
+	reqOpts ...RequestOption,
+) (MergeRequestApprovals, error) {
+	return s.c.GetMergeRequestApprovals(ctx, projectID, mrID, reqOpts...)
+}
+
+// GetApprovalState implementation
+func (s mergeRequestsService) GetApprovalState(
+	ctx context.Context,
+	projectID, mrID int,
+	reqOpts ...RequestOption,
+) (ApprovalState, error) {
+	return s.c.GetMergeRequestApprovalState(ctx, projectID, mrID, reqOpts...)
+}
+
+// ListApprovalRules implementation
+func (s mergeRequestsService) ListApprovalRules(
+	ctx context.Context,
+	projectID, mrID int,
+	reqOpts ...RequestOption,
+) ([]ApprovalRule, error) {
+	return s.c.ListMergeRequestApprovalRules(ctx, projectID, mrID, reqOpts...)
+}
+
+// CreateApprovalRule implementation
+func (s mergeRequestsService) CreateApprovalRule(
+	ctx context.Context,
+	projectID, mrID int,
+	opts ApprovalRuleOptions,
+	reqOpts ...RequestOption,
+) (ApprovalRule, error) {
+	return s.c.CreateMergeRequestApprovalRule(ctx, projectID, mrID, opts, reqOpts...)
+}
+
+// UpdateApprovalRule implementation
+func (s mergeRequestsService) UpdateApprovalRule(
+	ctx context.Context,
+	projectID, mrID, ruleID int,
+	opts UpdateApprovalRuleOptions,
+	reqOpts ...RequestOption,
+) (ApprovalRule, error) {
+	return s.c.UpdateMergeRequestApprovalRule(ctx, projectID, mrID, ruleID, opts, reqOpts...)
+}
+
+// DeleteApprovalRule implementation
+func (s mergeRequestsService) DeleteApprovalRule(
+	ctx context.Context,
+	projectID, mrID, ruleID int,
+	reqOpts ...RequestOption,
+) error {
+	return s.c.DeleteMergeRequestApprovalRule(ctx, projectID, mrID, ruleID, reqOpts...)
+}
+
+// ListApprovalRules implementation
+func (s projectsService) ListApprovalRules(
+	ctx context.Context,
+	projectID int,
+	reqOpts ...RequestOption,
+) ([]ApprovalRule, error) {
+	return s.c.ListProjectApprovalRules(ctx, projectID, reqOpts...)
+}
+
+// CreateApprovalRule implementation
+func (s projectsService) CreateApprovalRule(
+	ctx context.Context,
+	projectID int,
+	opts ApprovalRuleOptions,
+	reqOpts ...RequestOption,
+) (ApprovalRule, error) {
+	return s.c.CreateProjectApprovalRule(ctx, projectID, opts, reqOpts...)
+}
+
+// UpdateApprovalRule implementation
+func (s projectsService) UpdateApprovalRule(
+	ctx context.Context,
+	projectID, ruleID int,
+	opts UpdateApprovalRuleOptions,
+	reqOpts ...RequestOption,
+) (ApprovalRule, error) {
+	return s.c.UpdateProjectApprovalRule(ctx, projectID, ruleID, opts, reqOpts...)
+}
+
+// DeleteApprovalRule implementation
+func (s projectsService) DeleteApprovalRule(
+	ctx context.Context,
+	projectID, ruleID int,
+	reqOpts ...RequestOption,
+) error {
+	return s.c.DeleteProjectApprovalRule(ctx, projectID, ruleID, reqOpts...)
+}
+
+// ListHooks implementation
+func (s projectsService) ListHooks(
+	ctx context.Context,
+	projectID int,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) ([]Hook, error) {
+	return s.c.ListProjectHooks(ctx, projectID, opts, reqOpts...)
+}
+
+// GetHook implementation
+func (s projectsService) GetHook(ctx context.Context, projectID, hookID int, reqOpts ...RequestOption) (Hook, error) {
+	return s.c.GetProjectHook(ctx, projectID, hookID, reqOpts...)
+}
+
+// AddHook implementation
+func (s projectsService) AddHook(
+	ctx context.Context,
+	projectID int,
+	opts HookOptions,
+	reqOpts ...RequestOption,
+) (Hook, error) {
+	return s.c.AddProjectHook(ctx, projectID, opts, reqOpts...)
+}
+
+// EditHook implementation
+func (s projectsService) EditHook(
+	ctx context.Context,
+	projectID, hookID int,
+	opts HookOptions,
+	reqOpts ...RequestOption,
+) (Hook, error) {
+	return s.c.EditProjectHook(ctx, projectID, hookID, opts, reqOpts...)
+}
+
+// DeleteHook implementation
+func (s projectsService) DeleteHook(ctx context.Context, projectID, hookID int, reqOpts ...RequestOption) error {
+	return s.c.DeleteProjectHook(ctx, projectID, hookID, reqOpts...)
+}
+
+// TestHook implementation
+func (s projectsService) TestHook(
+	ctx context.Context,
+	projectID, hookID int,
+	trigger HookTrigger,
+	reqOpts ...RequestOption,
+) error {
+	return s.c.TestProjectHook(ctx, projectID, hookID, trigger, reqOpts...)
+}
+
+// SetHookUrlVariable implementation
+func (s projectsService) SetHookUrlVariable(
+	ctx context.Context,
+	projectID, hookID int,
+	key, value string,
+	reqOpts ...RequestOption,
+) error {
+	return s.c.SetProjectHookUrlVariable(ctx, projectID, hookID, key, value, reqOpts...)
+}
+
+// DeleteHookUrlVariable implementation
+func (s projectsService) DeleteHookUrlVariable(
+	ctx context.Context,
+	projectID, hookID int,
+	key string,
+	reqOpts ...RequestOption,
+) error {
+	return s.c.DeleteProjectHookUrlVariable(ctx, projectID, hookID, key, reqOpts...)
+}
+
+// ListHooks implementation
+func (s groupsService) ListHooks(
+	ctx context.Context,
+	groupID int,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) ([]Hook, error) {
+	return s.c.ListGroupHooks(ctx, groupID, opts, reqOpts...)
+}
+
+// GetHook implementation
+func (s groupsService) GetHook(ctx context.Context, groupID, hookID int, reqOpts ...RequestOption) (Hook, error) {
+	return s.c.GetGroupHook(ctx, groupID, hookID, reqOpts...)
+}
+
+// AddHook implementation
+func (s groupsService) AddHook(
+	ctx context.Context,
+	groupID int,
+	opts HookOptions,
+	reqOpts ...RequestOption,
+) (Hook, error) {
+	return s.c.AddGroupHook(ctx, groupID, opts, reqOpts...)
+}
+
+// EditHook implementation
+func (s groupsService) EditHook(
+	ctx context.Context,
+	groupID, hookID int,
+	opts HookOptions,
+	reqOpts ...RequestOption,
+) (Hook, error) {
+	return s.c.EditGroupHook(ctx, groupID, hookID, opts, reqOpts...)
+}
+
+// DeleteHook implementation
+func (s groupsService) DeleteHook(ctx context.Context, groupID, hookID int, reqOpts ...RequestOption) error {
+	return s.c.DeleteGroupHook(ctx, groupID, hookID, reqOpts...)
+}
+
+// TestHook implementation
+func (s groupsService) TestHook(
+	ctx context.Context,
+	groupID, hookID int,
+	trigger HookTrigger,
+	reqOpts ...RequestOption,
+) error {
+	return s.c.TestGroupHook(ctx, groupID, hookID, trigger, reqOpts...)
+}
+
+// SetHookUrlVariable implementation
+func (s groupsService) SetHookUrlVariable(
+	ctx context.Context,
+	groupID, hookID int,
+	key, value string,
+	reqOpts ...RequestOption,
+) error {
+	return s.c.SetGroupHookUrlVariable(ctx, groupID, hookID, key, value, reqOpts...)
+}
+
+// DeleteHookUrlVariable implementation
+func (s groupsService) DeleteHookUrlVariable(
+	ctx context.Context,
+	groupID, hookID int,
+	key string,
+	reqOpts ...RequestOption,
+) error {
+	return s.c.DeleteGroupHookUrlVariable(ctx, groupID, hookID, key, reqOpts...)
+}
+
+// List implementation
+func (s systemHooksService) List(
+	ctx context.Context,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) ([]SystemHook, error) {
+	return s.c.ListSystemHooks(ctx, opts, reqOpts...)
+}
+
+// Add implementation
+func (s systemHooksService) Add(
+	ctx context.Context,
+	opts SystemHookOptions,
+	reqOpts ...RequestOption,
+) (SystemHook, error) {
+	return s.c.AddSystemHook(ctx, opts, reqOpts...)
+}
+
+// Test implementation
+func (s systemHooksService) Test(ctx context.Context, hookID int, reqOpts ...RequestOption) error {
+	return s.c.TestSystemHook(ctx, hookID, reqOpts...)
+}
+
+// Delete implementation
+func (s systemHooksService) Delete(ctx context.Context, hookID int, reqOpts ...RequestOption) error {
+	return s.c.DeleteSystemHook(ctx, hookID, reqOpts...)
+}
+
+// List implementation
+func (s branchesService) List(
+	ctx context.Context,
+	projectID int,
+	opts ListBranchesOptions,
+	reqOpts ...RequestOption,
+) ([]Branch, error) {
+	return s.c.ListBranches(ctx, projectID, opts, reqOpts...)
+}
+
+// Get implementation
+func (s branchesService) Get(
+	ctx context.Context,
+	projectID int,
+	branch string,
+	reqOpts ...RequestOption,
+) (Branch, error) {
+	return s.c.GetBranch(ctx, projectID, branch, reqOpts...)
+}
+
+// Create implementation
+func (s branchesService) Create(
+	ctx context.Context,
+	projectID int,
+	branch, ref string,
+	reqOpts ...RequestOption,
+) (Branch, error) {
+	return s.c.CreateBranch(ctx, projectID, branch, ref, reqOpts...)
+}
+
+// Delete implementation
+func (s branchesService) Delete(ctx context.Context, projectID int, branch string, reqOpts ...RequestOption) error {
+	return s.c.DeleteBranch(ctx, projectID, branch, reqOpts...)
+}
+
+// DeleteMerged implementation
+func (s branchesService) DeleteMerged(ctx context.Context, projectID int, reqOpts ...RequestOption) error {
+	return s.c.DeleteMergedBranches(ctx, projectID, reqOpts...)
+}
+
+// List implementation
+func (s tagsService) List(
+	ctx context.Context,
+	projectID int,
+	opts ListTagsOptions,
+	reqOpts ...RequestOption,
+) ([]Tag, error) {
+	return s.c.ListTags(ctx, projectID, opts, reqOpts...)
+}
+
+// Get implementation
+func (s tagsService) Get(ctx context.Context, projectID int, tag string, reqOpts ...RequestOption) (Tag, error) {
+	return s.c.GetTag(ctx, projectID, tag, reqOpts...)
+}
+
+// Create implementation
+func (s tagsService) Create(
+	ctx context.Context,
+	projectID int,
+	opts CreateTagOptions,
+	reqOpts ...RequestOption,
+) (Tag, error) {
+	return s.c.CreateTag(ctx, projectID, opts, reqOpts...)
+}
+
+// Delete implementation
+func (s tagsService) Delete(ctx context.Context, projectID int, tag string, reqOpts ...RequestOption) error {
+	return s.c.DeleteTag(ctx, projectID, tag, reqOpts...)
+}
+
+// List implementation
+func (s protectedBranchesService) List(
+	ctx context.Context,
+	projectID int,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) ([]ProtectedBranch, error) {
+	return s.c.ListProtectedBranches(ctx, projectID, opts, reqOpts...)
+}
+
+// Get implementation
+func (s protectedBranchesService) Get(
+	ctx context.Context,
+	projectID int,
+	name string,
+	reqOpts ...RequestOption,
+) (ProtectedBranch, error) {
+	return s.c.GetProtectedBranch(ctx, projectID, name, reqOpts...)
+}
+
+// Protect implementation
+func (s protectedBranchesService) Protect(
+	ctx context.Context,
+	projectID int,
+	opts ProtectBranchOptions,
+	reqOpts ...RequestOption,
+) (ProtectedBranch, error) {
+	return s.c.ProtectBranch(ctx, projectID, opts, reqOpts...)
+}
+
+// Update implementation
+func (s protectedBranchesService) Update(
+	ctx context.Context,
+	projectID int,
+	name string,
+	opts UpdateProtectedBranchOptions,
+	reqOpts ...RequestOption,
+) (ProtectedBranch, error) {
+	return s.c.UpdateProtectedBranch(ctx, projectID, name, opts, reqOpts...)
+}
+
+// Unprotect implementation
+func (s protectedBranchesService) Unprotect(
+	ctx context.Context,
+	projectID int,
+	name string,
+	reqOpts ...RequestOption,
+) error {
+	return s.c.UnprotectBranch(ctx, projectID, name, reqOpts...)
+}
+
+// List implementation
+func (s protectedTagsService) List(
+	ctx context.Context,
+	projectID int,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) ([]ProtectedTag, error) {
+	return s.c.ListProtectedTags(ctx, projectID, opts, reqOpts...)
+}
+
+// Get implementation
+func (s protectedTagsService) Get(
+	ctx context.Context,
+	projectID int,
+	name string,
+	reqOpts ...RequestOption,
+) (ProtectedTag, error) {
+	return s.c.GetProtectedTag(ctx, projectID, name, reqOpts...)
+}
+
+// Protect implementation
+func (s protectedTagsService) Protect(
+	ctx context.Context,
+	projectID int,
+	opts ProtectTagOptions,
+	reqOpts ...RequestOption,
+) (ProtectedTag, error) {
+	return s.c.ProtectTag(ctx, projectID, opts, reqOpts...)
+}
+
+// Unprotect implementation
+func (s protectedTagsService) Unprotect(
+	ctx context.Context,
+	projectID int,
+	name string,
+	reqOpts ...RequestOption,
+) error {
+	return s.c.UnprotectTag(ctx, projectID, name, reqOpts...)
+}
+
+// ListTree implementation
+func (s repositoryService) ListTree(
+	ctx context.Context,
+	projectID int,
+	opts ListTreeOptions,
+	reqOpts ...RequestOption,
+) ([]TreeNode, error) {
+	return s.c.ListTree(ctx, projectID, opts, reqOpts...)
+}
+
+// Compare implementation
+func (s repositoryService) Compare(
+	ctx context.Context,
+	projectID int,
+	from, to string,
+	reqOpts ...RequestOption,
+) (Comparison, error) {
+	return s.c.Compare(ctx, projectID, from, to, reqOpts...)
+}
+
+// ListContributors implementation
+func (s repositoryService) ListContributors(
+	ctx context.Context,
+	projectID int,
+	opts ListContributorsOptions,
+	reqOpts ...RequestOption,
+) ([]Contributor, error) {
+	return s.c.ListContributors(ctx, projectID, opts, reqOpts...)
+}
+
+// GetArchive implementation
+func (s repositoryService) GetArchive(
+	ctx context.Context,
+	projectID int,
+	w io.Writer,
+	opts ArchiveOptions,
+	reqOpts ...RequestOption,
+) error {
+	return s.c.GetArchive(ctx, projectID, w, opts, reqOpts...)
+}
+
+// List implementation
+func (s awardEmojisService) List(
+	ctx context.Context,
+	awardable Awardable,
+	opts ListOptions,
+	reqOpts ...RequestOption,
+) ([]AwardEmoji, error) {
+	return s.c.ListAwardEmoji(ctx, awardable, opts, reqOpts...)
+}
+
+// Add implementation
+func (s awardEmojisService) Add(
+	ctx context.Context,
+	awardable Awardable,
+	name string,
+	reqOpts ...RequestOption,
+) (AwardEmoji, error) {
+	return s.c.AddAwardEmoji(ctx, awardable, name, reqOpts...)
+}
+
+// Remove implementation
+func (s awardEmojisService) Remove(
+	ctx context.Context,
+	awardable Awardable,
+	awardID int,
+	reqOpts ...RequestOption,
+) error {
+	return s.c.RemoveAwardEmoji(ctx, awardable, awardID, reqOpts...)
+}
+
+// Version implementation
+func (s instanceService) Version(ctx context.Context, reqOpts ...RequestOption) (Version, error) {
+	return s.c.GetVersion(ctx, reqOpts...)
+}
+
+// Metadata implementation
+func (s instanceService) Metadata(ctx context.Context, reqOpts ...RequestOption) (Metadata, error) {
+	return s.c.GetMetadata(ctx, reqOpts...)
+}
+
+// SupportsFeature implementation
+func (s instanceService) SupportsFeature(ctx context.Context, feature Feature, reqOpts ...RequestOption) (bool, error) {
+	return s.c.SupportsFeature(ctx, feature, reqOpts...)
+}
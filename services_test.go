@@ -0,0 +1,66 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestClient_Services(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	t.Run("users", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"//users/5", req.URL.String())
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 5}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		client := gitlab.NewClient("test_token", gitlab.WithBaseUrl(baseUrl), gitlab.WithHttpClient(httpClient))
+
+		user, err := client.Users().GetByID(context.Background(), 5)
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.User{ID: 5}, user)
+	})
+
+	t.Run("protected branches", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodDelete, req.Method)
+			assert.Equal(t, baseUrl+"/projects/10/protected_branches/release%2F%2A", req.URL.String())
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusNoContent,
+		}, nil).Once()
+
+		client := gitlab.NewClient("test_token", gitlab.WithBaseUrl(baseUrl), gitlab.WithHttpClient(httpClient))
+
+		assert.NoError(t, client.ProtectedBranches().Unprotect(context.Background(), 10, "release/*"))
+	})
+
+	t.Run("service mock", func(t *testing.T) {
+		discussions := new(gitlab.MockDiscussions)
+		discussions.EXPECT().Get(mock.Anything, 10, 20, "abc").Return(gitlab.Discussion{ID: "abc"}, nil)
+
+		var service gitlab.Discussions = discussions
+
+		discussion, err := service.Get(context.Background(), 10, 20, "abc")
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", discussion.ID)
+	})
+}
@@ -0,0 +1,111 @@
+// Package gitlab - tag
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+type (
+	// Tag entity
+	Tag struct {
+		Name      string   `json:"name"`
+		Message   string   `json:"message"`
+		Target    string   `json:"target"`
+		Protected bool     `json:"protected"`
+		Commit    Commit   `json:"commit"`
+		Release   *Release `json:"release"`
+	}
+
+	// Release (release notes of the tag) entity
+	Release struct {
+		TagName     string `json:"tag_name"`
+		Description string `json:"description"`
+	}
+
+	// ListTagsOptions contains parameters of tags list request
+	ListTagsOptions struct {
+		ListOptions
+
+		// Search returns only tags containing the search string
+		Search string
+		// OrderBy is one of "name", "updated" or "version"
+		OrderBy string
+		// Sort is one of "asc" or "desc"
+		Sort string
+	}
+
+	// CreateTagOptions contains parameters of tag creation request
+	CreateTagOptions struct {
+		TagName            string `json:"tag_name"`
+		Ref                string `json:"ref"`
+		Message            string `json:"message,omitempty"`
+		ReleaseDescription string `json:"release_description,omitempty"`
+	}
+)
+
+func (opts ListTagsOptions) values() url.Values {
+	values := opts.ListOptions.values()
+	if opts.Search != "" {
+		values.Set("search", opts.Search)
+	}
+
+	if opts.OrderBy != "" {
+		values.Set("order_by", opts.OrderBy)
+	}
+
+	if opts.Sort != "" {
+		values.Set("sort", opts.Sort)
+	}
+
+	return values
+}
+
+func listTags(ctx context.Context, c *client, projectID int, opts ListTagsOptions) ([]Tag, error) {
+	path := fmt.Sprintf("projects/%d/repository/tags", projectID)
+	resp, err := c.get(ctx, withQuery(path, opts.values()))
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []Tag
+	if err = json.Unmarshal(resp, &tags); err != nil {
+		return nil, fmt.Errorf("can't unmarshal tags data: %w", err)
+	}
+
+	return tags, nil
+}
+
+func getTag(ctx context.Context, c *client, projectID int, tag string) (Tag, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("projects/%d/repository/tags/%s", projectID, url.PathEscape(tag)))
+	if err != nil {
+		return Tag{}, err
+	}
+
+	var t Tag
+	if err = json.Unmarshal(resp, &t); err != nil {
+		return Tag{}, fmt.Errorf("can't unmarshal tag data: %w", err)
+	}
+
+	return t, nil
+}
+
+func createTag(ctx context.Context, c *client, projectID int, opts CreateTagOptions) (Tag, error) {
+	resp, err := c.post(ctx, fmt.Sprintf("projects/%d/repository/tags", projectID), opts)
+	if err != nil {
+		return Tag{}, err
+	}
+
+	var t Tag
+	if err = json.Unmarshal(resp, &t); err != nil {
+		return Tag{}, fmt.Errorf("can't unmarshal tag data: %w", err)
+	}
+
+	return t, nil
+}
+
+func deleteTag(ctx context.Context, c *client, projectID int, tag string) error {
+	return c.delete(ctx, fmt.Sprintf("projects/%d/repository/tags/%s", projectID, url.PathEscape(tag)))
+}
@@ -0,0 +1,169 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestClient_ListTags(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/projects/10/repository/tags?order_by=version&search=v1&sort=desc", req.URL.String())
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(
+				`[{"name": "v1.1.0", "release": {"tag_name": "v1.1.0", "description": "notes"}}, {"name": "v1.0.0"}]`,
+			))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		tags, err := client.Tags().List(context.Background(), 10, gitlab.ListTagsOptions{
+			Search:  "v1",
+			OrderBy: "version",
+			Sort:    "desc",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []gitlab.Tag{
+			{Name: "v1.1.0", Release: &gitlab.Release{TagName: "v1.1.0", Description: "notes"}},
+			{Name: "v1.0.0"},
+		}, tags)
+	})
+
+	t.Run("error on getting tags", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expErr)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		tags, err := client.Tags().List(context.Background(), 10, gitlab.ListTagsOptions{})
+		assert.True(t, errors.Is(err, expErr))
+		assert.Equal(t, []gitlab.Tag(nil), tags)
+	})
+}
+
+func TestClient_GetTag(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, baseUrl+"/projects/10/repository/tags/v1.0.0", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"name": "v1.0.0", "target": "abc"}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	tag, err := client.Tags().Get(context.Background(), 10, "v1.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, gitlab.Tag{Name: "v1.0.0", Target: "abc"}, tag)
+}
+
+func TestClient_CreateTag(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://gitlab.test.com/api/v4"
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, baseUrl+"/projects/10/repository/tags", req.URL.String())
+
+			body, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"tag_name": "v1.0.0", "ref": "master", "release_description": "notes"}`, string(body))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"name": "v1.0.0", "release": {"description": "notes"}}`))),
+			StatusCode: http.StatusCreated,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		tag, err := client.Tags().Create(context.Background(), 10, gitlab.CreateTagOptions{
+			TagName:            "v1.0.0",
+			Ref:                "master",
+			ReleaseDescription: "notes",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.Tag{Name: "v1.0.0", Release: &gitlab.Release{Description: "notes"}}, tag)
+	})
+
+	t.Run("error on unmarshal response", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{"))),
+			StatusCode: http.StatusCreated,
+		}, nil)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		tag, err := client.Tags().Create(context.Background(), 10, gitlab.CreateTagOptions{TagName: "v1.0.0", Ref: "master"})
+		assert.Error(t, err)
+		assert.Equal(t, gitlab.Tag{}, tag)
+	})
+}
+
+func TestClient_DeleteTag(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodDelete, req.Method)
+		assert.Equal(t, baseUrl+"/projects/10/repository/tags/v1.0.0", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		StatusCode: http.StatusNoContent,
+	}, nil)
+
+	client := gitlab.NewClient(
+		"test_token",
+		gitlab.WithBaseUrl(baseUrl),
+		gitlab.WithHttpClient(httpClient),
+	)
+
+	assert.NoError(t, client.Tags().Delete(context.Background(), 10, "v1.0.0"))
+}
@@ -0,0 +1,109 @@
+// Package gitlab - time
+package gitlab
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// dateLayout is a layout of date-only fields
+const dateLayout = "2006-01-02"
+
+// timeLayouts are layouts of timestamps gitlab uses in api responses and webhook payloads
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02T15:04:05.999999999",
+	dateLayout,
+}
+
+type (
+	// Date is a date without time (due date, start date etc.)
+	Date time.Time
+
+	// Time is a timestamp of entities decoded from any of gitlab formats and encoded in RFC 3339 format
+	Time struct {
+		time.Time
+	}
+)
+
+// ParseTime parses timestamp in any of gitlab formats
+func ParseTime(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("can't parse time %q", s)
+}
+
+// unmarshalTime decodes json timestamp in any of gitlab formats, null and empty string give zero time
+func unmarshalTime(data []byte) (time.Time, error) {
+	s, err := unquoteTime(data)
+	if err != nil || s == "" {
+		return time.Time{}, err
+	}
+
+	return ParseTime(s)
+}
+
+// ParseDate parses date in YYYY-MM-DD format
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("can't parse date %q: %w", s, err)
+	}
+
+	return Date(t), nil
+}
+
+// Time returns date as time at midnight UTC
+func (d Date) Time() time.Time {
+	return time.Time(d)
+}
+
+// String returns date in YYYY-MM-DD format
+func (d Date) String() string {
+	return time.Time(d).Format(dateLayout)
+}
+
+// MarshalJSON implements json.Marshaler
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Date) UnmarshalJSON(data []byte) error {
+	t, err := unmarshalTime(data)
+	if err != nil || t.IsZero() {
+		return err
+	}
+
+	*d = Date(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (t *Time) UnmarshalJSON(data []byte) (err error) {
+	t.Time, err = unmarshalTime(data)
+	return err
+}
+
+func unquoteTime(data []byte) (string, error) {
+	if bytes.Equal(data, []byte("null")) {
+		return "", nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return "", fmt.Errorf("time must be a string: %w", err)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,97 @@
+package gitlab_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func TestParseTime(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		expTime := time.Date(2020, 10, 1, 10, 20, 30, 0, time.UTC)
+
+		for _, s := range []string{
+			"2020-10-01T10:20:30Z",
+			"2020-10-01T10:20:30.000Z",
+			"2020-10-01T13:20:30.000+03:00",
+			"2020-10-01 10:20:30 UTC",
+			"2020-10-01 13:20:30 +0300",
+			"2020-10-01T13:20:30+0300",
+			"2020-10-01T10:20:30",
+		} {
+			parsed, err := gitlab.ParseTime(s)
+			assert.NoError(t, err, s)
+			assert.True(t, expTime.Equal(parsed), s)
+		}
+	})
+
+	t.Run("invalid time", func(t *testing.T) {
+		_, err := gitlab.ParseTime("yesterday")
+		assert.Error(t, err)
+	})
+}
+
+func TestDate_JSON(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		var date gitlab.Date
+		assert.NoError(t, json.Unmarshal([]byte(`"2020-10-01"`), &date))
+		assert.Equal(t, time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC), date.Time())
+		assert.Equal(t, "2020-10-01", date.String())
+
+		data, err := json.Marshal(date)
+		assert.NoError(t, err)
+		assert.Equal(t, `"2020-10-01"`, string(data))
+	})
+
+	t.Run("null", func(t *testing.T) {
+		var entity struct {
+			DueDate *gitlab.Date `json:"due_date"`
+		}
+
+		assert.NoError(t, json.Unmarshal([]byte(`{"due_date": null}`), &entity))
+		assert.Nil(t, entity.DueDate)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		var date gitlab.Date
+		assert.Error(t, json.Unmarshal([]byte(`"tomorrow"`), &date))
+		assert.Error(t, json.Unmarshal([]byte(`20201001`), &date))
+	})
+}
+
+func TestNote_JSON(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		var note gitlab.Note
+		assert.NoError(t, json.Unmarshal([]byte(
+			`{"id": 1, "body": "test", "created_at": "2020-10-01T10:20:30.000Z", "updated_at": "2020-10-01 10:30:00 UTC"}`,
+		), &note))
+
+		assert.Equal(t, 1, note.ID)
+		assert.Equal(t, "test", note.Body)
+		assert.True(t, time.Date(2020, 10, 1, 10, 20, 30, 0, time.UTC).Equal(note.CreatedAt.Time))
+		assert.True(t, time.Date(2020, 10, 1, 10, 30, 0, 0, time.UTC).Equal(note.UpdatedAt.Time))
+
+		data, err := json.Marshal(note)
+		assert.NoError(t, err)
+
+		var decoded gitlab.Note
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, note, decoded)
+	})
+
+	t.Run("absent and null timestamps", func(t *testing.T) {
+		var note gitlab.Note
+		assert.NoError(t, json.Unmarshal([]byte(`{"id": 1, "updated_at": null}`), &note))
+		assert.Nil(t, note.CreatedAt)
+		assert.Nil(t, note.UpdatedAt)
+	})
+
+	t.Run("invalid timestamp", func(t *testing.T) {
+		var note gitlab.Note
+		assert.Error(t, json.Unmarshal([]byte(`{"id": 1, "created_at": "yesterday"}`), &note))
+	})
+}
@@ -0,0 +1,146 @@
+// Package gitlab - tracing
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+)
+
+// Attribute keys recorded on spans
+const (
+	AttrProjectID       = "gitlab.project_id"
+	AttrMergeRequestIID = "gitlab.merge_request_iid"
+	AttrGroupID         = "gitlab.group_id"
+	AttrUserID          = "gitlab.user_id"
+	AttrHTTPMethod      = "http.method"
+	AttrHTTPURL         = "http.url"
+	AttrHTTPTarget      = "http.target"
+	AttrHTTPStatusCode  = "http.status_code"
+	AttrHTTPAttempt     = "http.attempt"
+)
+
+type (
+	// Tracer starts spans, it can be implemented on top of OpenTelemetry tracer
+	Tracer interface {
+		// Start creates span as a child of span from ctx and returns context with the new span
+		Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+	}
+
+	// Span is a traced operation
+	Span interface {
+		SetAttributes(attrs ...Attribute)
+		RecordError(err error)
+		End()
+	}
+
+	// Attribute is a key/value pair describing span
+	Attribute struct {
+		Key   string
+		Value interface{}
+	}
+
+	// span ends wrapped span recording error, nil span is no-op
+	span struct {
+		span Span
+	}
+
+	attemptCounterKey struct{}
+)
+
+// startSpan starts span if tracing is enabled
+func (c *client) startSpan(ctx context.Context, name string, attrs ...Attribute) (context.Context, *span) {
+	if c.tracer == nil {
+		return ctx, nil
+	}
+
+	ctx, s := c.tracer.Start(ctx, name, attrs...)
+
+	return ctx, &span{span: s}
+}
+
+// withAttemptCounter adds counter of http attempts made by middlewares for a single request if tracing is enabled
+func (c *client) withAttemptCounter(ctx context.Context) context.Context {
+	if c.tracer == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, attemptCounterKey{}, new(int32))
+}
+
+// startAttemptSpan starts child span of a single http attempt
+func (c *client) startAttemptSpan(req *http.Request) (*http.Request, *span) {
+	if c.tracer == nil {
+		return req, nil
+	}
+
+	attrs := []Attribute{
+		methodAttr(req.Method),
+		{Key: AttrHTTPURL, Value: redactURL(req.URL)},
+	}
+
+	if counter, ok := req.Context().Value(attemptCounterKey{}).(*int32); ok {
+		attrs = append(attrs, Attribute{Key: AttrHTTPAttempt, Value: int(atomic.AddInt32(counter, 1))})
+	}
+
+	ctx, s := c.startSpan(req.Context(), "HTTP "+req.Method, attrs...)
+
+	return req.WithContext(ctx), s
+}
+
+// redactURL returns url without user info and with credential query parameters hidden
+func redactURL(u *url.URL) string {
+	path := u.EscapedPath()
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+
+	return u.Scheme + "://" + u.Host + redactPath(path)
+}
+
+func (s *span) setStatus(resp *http.Response) {
+	if s == nil || resp == nil {
+		return
+	}
+
+	s.span.SetAttributes(Attribute{Key: AttrHTTPStatusCode, Value: resp.StatusCode})
+}
+
+// end records error pointed by errp and ends span
+func (s *span) end(errp *error) {
+	if s == nil {
+		return
+	}
+
+	if errp != nil && *errp != nil {
+		s.span.RecordError(*errp)
+	}
+
+	s.span.End()
+}
+
+func methodAttr(method string) Attribute {
+	return Attribute{Key: AttrHTTPMethod, Value: method}
+}
+
+// targetAttr returns path with query of request, credentials in query are redacted
+func targetAttr(path string) Attribute {
+	return Attribute{Key: AttrHTTPTarget, Value: redactPath(path)}
+}
+
+func projectAttr(projectID int) Attribute {
+	return Attribute{Key: AttrProjectID, Value: projectID}
+}
+
+func mergeRequestAttr(mrID int) Attribute {
+	return Attribute{Key: AttrMergeRequestIID, Value: mrID}
+}
+
+func groupAttr(groupID int) Attribute {
+	return Attribute{Key: AttrGroupID, Value: groupID}
+}
+
+func userAttr(userID int) Attribute {
+	return Attribute{Key: AttrUserID, Value: userID}
+}
@@ -0,0 +1,236 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+type (
+	testTracer struct {
+		mu    sync.Mutex
+		spans []*testSpan
+	}
+
+	testSpan struct {
+		name   string
+		parent *testSpan
+		attrs  map[string]interface{}
+		err    error
+		ended  bool
+	}
+
+	testSpanKey struct{}
+)
+
+func (t *testTracer) Start(ctx context.Context, name string, attrs ...gitlab.Attribute) (context.Context, gitlab.Span) {
+	parent, _ := ctx.Value(testSpanKey{}).(*testSpan)
+	span := &testSpan{name: name, parent: parent, attrs: map[string]interface{}{}}
+	span.SetAttributes(attrs...)
+
+	t.mu.Lock()
+	t.spans = append(t.spans, span)
+	t.mu.Unlock()
+
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+func (t *testTracer) find(name string) []*testSpan {
+	var res []*testSpan
+	for _, span := range t.spans {
+		if span.name == name {
+			res = append(res, span)
+		}
+	}
+
+	return res
+}
+
+func (s *testSpan) SetAttributes(attrs ...gitlab.Attribute) {
+	for _, attr := range attrs {
+		s.attrs[attr.Key] = attr.Value
+	}
+}
+
+func (s *testSpan) RecordError(err error) {
+	s.err = err
+}
+
+func (s *testSpan) End() {
+	s.ended = true
+}
+
+func TestClient_WithTracer(t *testing.T) {
+	baseUrl := "http://gitlab.test.com/api/v4"
+
+	t.Run("operation with http attempt", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": "abc"}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		tracer := new(testTracer)
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithTracer(tracer),
+		)
+
+		_, err := client.GetDiscussion(context.Background(), 10, 20, "abc")
+		assert.NoError(t, err)
+
+		if assert.Len(t, tracer.spans, 2) {
+			operation, attempt := tracer.spans[0], tracer.spans[1]
+
+			assert.Equal(t, "GetDiscussion", operation.name)
+			assert.Nil(t, operation.parent)
+			assert.Equal(t, 10, operation.attrs[gitlab.AttrProjectID])
+			assert.Equal(t, 20, operation.attrs[gitlab.AttrMergeRequestIID])
+			assert.True(t, operation.ended)
+			assert.NoError(t, operation.err)
+
+			assert.Equal(t, "HTTP GET", attempt.name)
+			assert.Equal(t, operation, attempt.parent)
+			assert.Equal(t, baseUrl+"/projects/10/merge_requests/20/discussions/abc", attempt.attrs[gitlab.AttrHTTPURL])
+			assert.Equal(t, http.StatusOK, attempt.attrs[gitlab.AttrHTTPStatusCode])
+			assert.True(t, attempt.ended)
+		}
+	})
+
+	t.Run("fan-out", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(
+			func(req *http.Request) *http.Response {
+				var id int
+				_, _ = fmt.Sscanf(req.URL.Path, "/api/v4/users/%d", &id)
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(fmt.Sprintf(`{"id": %d}`, id)))),
+					StatusCode: http.StatusOK,
+				}
+			},
+			nil,
+		)
+
+		tracer := new(testTracer)
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithTracer(tracer),
+			gitlab.WithConcurrency(3),
+		)
+
+		_, err := client.GetUsersByIDs(context.Background(), []int{1, 2, 3})
+		assert.NoError(t, err)
+
+		operations := tracer.find("GetUsersByIDs")
+		if assert.Len(t, operations, 1) {
+			var ids []int
+			for _, span := range tracer.find("GetUserByID") {
+				assert.Equal(t, operations[0], span.parent)
+				ids = append(ids, span.attrs[gitlab.AttrUserID].(int))
+			}
+
+			sort.Ints(ids)
+			assert.Equal(t, []int{1, 2, 3}, ids)
+		}
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusForbidden,
+		}, nil).Once()
+
+		tracer := new(testTracer)
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithTracer(tracer),
+		)
+
+		err := client.Groups().DeleteHook(context.Background(), 5, 7)
+		assert.Error(t, err)
+
+		operations := tracer.find("DeleteGroupHook")
+		if assert.Len(t, operations, 1) {
+			assert.Equal(t, 5, operations[0].attrs[gitlab.AttrGroupID])
+			assert.Equal(t, err, operations[0].err)
+		}
+	})
+
+	t.Run("raw request retried by middleware", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusBadGateway,
+		}, nil).Once()
+
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		tracer := new(testTracer)
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(baseUrl),
+			gitlab.WithHttpClient(httpClient),
+			gitlab.WithTracer(tracer),
+			gitlab.WithMiddleware(func(next gitlab.RoundTripFunc) gitlab.RoundTripFunc {
+				return func(req *http.Request) (*http.Response, error) {
+					resp, err := next(req)
+					if err == nil && resp.StatusCode == http.StatusBadGateway {
+						return next(req)
+					}
+
+					return resp, err
+				}
+			}),
+		)
+
+		_, err := client.SendRequest(context.Background(), http.MethodGet, "version?private_token=secret", nil)
+		assert.NoError(t, err)
+
+		operations := tracer.find("SendRequest")
+		if assert.Len(t, operations, 1) {
+			assert.Nil(t, operations[0].parent)
+			assert.Equal(t, http.MethodGet, operations[0].attrs[gitlab.AttrHTTPMethod])
+			assert.Equal(t, "version?private_token=[REDACTED]", operations[0].attrs[gitlab.AttrHTTPTarget])
+			assert.True(t, operations[0].ended)
+
+			attempts := tracer.find("HTTP GET")
+			if assert.Len(t, attempts, 2) {
+				for i, attempt := range attempts {
+					assert.Equal(t, operations[0], attempt.parent)
+					assert.Equal(t, i+1, attempt.attrs[gitlab.AttrHTTPAttempt])
+				}
+
+				assert.Equal(t, http.StatusBadGateway, attempts[0].attrs[gitlab.AttrHTTPStatusCode])
+				assert.Equal(t, http.StatusOK, attempts[1].attrs[gitlab.AttrHTTPStatusCode])
+				assert.Equal(t, baseUrl+"/version?private_token=[REDACTED]", attempts[0].attrs[gitlab.AttrHTTPURL])
+			}
+		}
+
+		for _, span := range tracer.spans {
+			for key, value := range span.attrs {
+				assert.NotContains(t, fmt.Sprint(value), "secret", "%s attribute of %s span", key, span.name)
+			}
+		}
+	})
+}
@@ -0,0 +1,135 @@
+// Package gitlab - transport
+package gitlab
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Timeouts of default http client, duration of attempts is not limited to not cut off downloads of archives
+var (
+	defaultResponseHeaderTimeout = 30 * time.Second
+	defaultDialTimeout           = 30 * time.Second
+	defaultTLSHandshakeTimeout   = 10 * time.Second
+)
+
+// transportConfig contains parameters of default http client, they are ignored if WithHttpClient is used
+type transportConfig struct {
+	tls *tls.Config
+	// proxy replaces proxy of environment (HTTPS_PROXY etc.) if setProxy is true, nil proxy means direct connections
+	proxy    *url.URL
+	setProxy bool
+
+	attemptTimeout        time.Duration
+	responseHeaderTimeout time.Duration
+	dialTimeout           time.Duration
+	tlsHandshakeTimeout   time.Duration
+	idleConnTimeout       time.Duration
+	maxIdleConns          int
+	disableHTTP2          bool
+
+	// err is a configuration error returned by every request
+	err error
+}
+
+func newTransportConfig() transportConfig {
+	return transportConfig{
+		responseHeaderTimeout: defaultResponseHeaderTimeout,
+		dialTimeout:           defaultDialTimeout,
+		tlsHandshakeTimeout:   defaultTLSHandshakeTimeout,
+	}
+}
+
+// httpClient returns http client built by configuration,
+// idle connections per host are limited by concurrency unless set explicitly
+func (t *transportConfig) httpClient(concurrency int) HTTPClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if t.tls != nil {
+		transport.TLSClientConfig = t.tls
+	}
+
+	if t.setProxy {
+		transport.Proxy = http.ProxyURL(t.proxy)
+	}
+
+	if t.dialTimeout > 0 {
+		transport.DialContext = (&net.Dialer{
+			Timeout:   t.dialTimeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext
+	}
+
+	transport.ResponseHeaderTimeout = t.responseHeaderTimeout
+
+	if t.tlsHandshakeTimeout > 0 {
+		transport.TLSHandshakeTimeout = t.tlsHandshakeTimeout
+	}
+
+	if t.idleConnTimeout > 0 {
+		transport.IdleConnTimeout = t.idleConnTimeout
+	}
+
+	transport.MaxIdleConnsPerHost = concurrency
+	if t.maxIdleConns > 0 {
+		transport.MaxIdleConnsPerHost = t.maxIdleConns
+	}
+
+	if transport.MaxIdleConns < transport.MaxIdleConnsPerHost {
+		transport.MaxIdleConns = transport.MaxIdleConnsPerHost
+	}
+
+	if t.disableHTTP2 {
+		transport.ForceAttemptHTTP2 = false
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+
+	return &http.Client{Transport: transport, Timeout: t.attemptTimeout}
+}
+
+func (t *transportConfig) fail(err error) {
+	if t.err == nil {
+		t.err = err
+	}
+}
+
+func (t *transportConfig) tlsConfig() *tls.Config {
+	if t.tls == nil {
+		t.tls = &tls.Config{}
+	}
+
+	return t.tls
+}
+
+// addRootCAs adds certificates of PEM bundle to system pool
+func (t *transportConfig) addRootCAs(pem []byte) error {
+	cfg := t.tlsConfig()
+	if cfg.RootCAs == nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		cfg.RootCAs = pool
+	}
+
+	if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+		return errors.New("can't add ca certificates: no certificates found in pem")
+	}
+
+	return nil
+}
+
+func readPEMFile(kind string, filename string) ([]byte, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("can't read %s file: %w", kind, err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,40 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClient_DefaultTimeouts(t *testing.T) {
+	t.Run("default http client", func(t *testing.T) {
+		c := NewClient("test_token").(*client)
+		assert.Equal(t, 30*time.Second, c.transport.dialTimeout)
+
+		httpClient, ok := c.httpClient.(*http.Client)
+		if assert.True(t, ok) {
+			assert.Zero(t, httpClient.Timeout)
+			assert.Equal(t, 30*time.Second, httpClient.Transport.(*http.Transport).ResponseHeaderTimeout)
+			assert.Equal(t, 10*time.Second, httpClient.Transport.(*http.Transport).TLSHandshakeTimeout)
+		}
+	})
+
+	t.Run("hanging server", func(t *testing.T) {
+		hanging := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		}))
+		defer hanging.Close()
+
+		timeout := defaultResponseHeaderTimeout
+		defaultResponseHeaderTimeout = 50 * time.Millisecond
+		defer func() { defaultResponseHeaderTimeout = timeout }()
+
+		_, err := NewClient("test_token", WithBaseUrl(hanging.URL)).GetUserByID(context.Background(), 5)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "timeout awaiting response headers")
+	})
+}
@@ -0,0 +1,281 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+// newTestCertificate returns PEM encoded self-signed client certificate and its key
+func newTestCertificate(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "gitlab client"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func newTLSServer(clientCA []byte, maxVersion uint16) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": 5}`))
+	}))
+
+	server.TLS = &tls.Config{MaxVersion: maxVersion}
+	if clientCA != nil {
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM(clientCA)
+
+		server.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+		server.TLS.ClientCAs = pool
+	}
+
+	server.StartTLS()
+
+	return server
+}
+
+func serverCertPEM(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+func TestClient_TLSOptions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("custom ca", func(t *testing.T) {
+		server := newTLSServer(nil, 0)
+		defer server.Close()
+
+		client := gitlab.NewClient("test_token", gitlab.WithBaseUrl(server.URL))
+		_, err := client.GetUserByID(ctx, 5)
+		assert.Error(t, err)
+
+		client = gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(server.URL),
+			gitlab.WithCACertPEM(serverCertPEM(server)),
+		)
+		user, err := client.GetUserByID(ctx, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, user.ID)
+	})
+
+	t.Run("insecure", func(t *testing.T) {
+		server := newTLSServer(nil, 0)
+		defer server.Close()
+
+		client := gitlab.NewClient("test_token", gitlab.WithBaseUrl(server.URL), gitlab.WithInsecureSkipVerify())
+		_, err := client.GetUserByID(ctx, 5)
+		assert.NoError(t, err)
+	})
+
+	t.Run("client certificate", func(t *testing.T) {
+		certPEM, keyPEM := newTestCertificate(t)
+
+		server := newTLSServer(certPEM, 0)
+		defer server.Close()
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(server.URL),
+			gitlab.WithCACertPEM(serverCertPEM(server)),
+		)
+		_, err := client.GetUserByID(ctx, 5)
+		assert.Error(t, err)
+
+		dir, err := ioutil.TempDir("", "gitlab")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		certFile, keyFile := filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+		assert.NoError(t, ioutil.WriteFile(certFile, certPEM, 0600))
+		assert.NoError(t, ioutil.WriteFile(keyFile, keyPEM, 0600))
+
+		client = gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(server.URL),
+			gitlab.WithCACertPEM(serverCertPEM(server)),
+			gitlab.WithClientCertFile(certFile, keyFile),
+		)
+		_, err = client.GetUserByID(ctx, 5)
+		assert.NoError(t, err)
+	})
+
+	t.Run("min tls version", func(t *testing.T) {
+		server := newTLSServer(nil, tls.VersionTLS12)
+		defer server.Close()
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(server.URL),
+			gitlab.WithCACertPEM(serverCertPEM(server)),
+			gitlab.WithMinTLSVersion(tls.VersionTLS13),
+		)
+		_, err := client.GetUserByID(ctx, 5)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		client := gitlab.NewClient("test_token", gitlab.WithCACertFile("/not/existing/ca.pem"))
+		_, err := client.GetUserByID(ctx, 5)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid http client configuration: can't read ca certificate file")
+
+		client = gitlab.NewClient("test_token", gitlab.WithClientCertPEM([]byte("cert"), []byte("key")))
+		_, err = client.GetUserByID(ctx, 5)
+		assert.Contains(t, err.Error(), "can't load client certificate")
+
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": 5}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		client = gitlab.NewClient(
+			"test_token",
+			gitlab.WithCACertFile("/not/existing/ca.pem"),
+			gitlab.WithHttpClient(httpClient),
+		)
+		user, err := client.GetUserByID(ctx, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, user.ID)
+	})
+}
+
+func TestClient_TransportOptions(t *testing.T) {
+	ctx := context.Background()
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+
+		_, _ = w.Write([]byte(`{"id": 5}`))
+	}))
+	defer slow.Close()
+
+	t.Run("timeout", func(t *testing.T) {
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(slow.URL),
+			gitlab.WithHttpClient(http.DefaultClient),
+			gitlab.WithTimeout(50*time.Millisecond),
+		)
+
+		_, err := client.GetUserByID(ctx, 5)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+
+	t.Run("attempt timeout", func(t *testing.T) {
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(slow.URL),
+			gitlab.WithAttemptTimeout(50*time.Millisecond),
+		)
+
+		_, err := client.GetUserByID(ctx, 5)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Client.Timeout exceeded")
+	})
+
+	t.Run("response header timeout", func(t *testing.T) {
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl(slow.URL),
+			gitlab.WithResponseHeaderTimeout(50*time.Millisecond),
+		)
+
+		_, err := client.GetUserByID(ctx, 5)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "timeout awaiting response headers")
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		var proxied string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied = r.Host
+			_, _ = w.Write([]byte(`{"id": 5}`))
+		}))
+		defer proxy.Close()
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithBaseUrl("http://gitlab.test.com/api/v4"),
+			gitlab.WithProxy(proxy.URL),
+		)
+
+		user, err := client.GetUserByID(ctx, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, user.ID)
+		assert.Equal(t, "gitlab.test.com", proxied)
+
+		client = gitlab.NewClient("test_token", gitlab.WithProxy("http://proxy\x7f"))
+		_, err = client.GetUserByID(ctx, 5)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid http client configuration: can't parse proxy url")
+
+		var urlErr *url.Error
+		assert.True(t, errors.As(err, &urlErr))
+	})
+
+	t.Run("http2", func(t *testing.T) {
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"id": ` + strconv.Itoa(r.ProtoMajor) + `}`))
+		}))
+		server.EnableHTTP2 = true
+		server.StartTLS()
+		defer server.Close()
+
+		for enabled, proto := range map[bool]int{true: 2, false: 1} {
+			client := gitlab.NewClient(
+				"test_token",
+				gitlab.WithBaseUrl(server.URL),
+				gitlab.WithCACertPEM(serverCertPEM(server)),
+				gitlab.WithHTTP2(enabled),
+			)
+
+			user, err := client.GetUserByID(ctx, 5)
+			assert.NoError(t, err)
+			assert.Equal(t, proto, user.ID)
+		}
+	})
+}
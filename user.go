@@ -35,6 +35,7 @@ func getUsersByIDs(parentCtx context.Context, c *client, ids []int) ([]User, err
 	ctx, cancelFunc := context.WithCancel(parentCtx)
 	defer cancelFunc()
 
+	var mu sync.Mutex
 	users := make([]User, 0, len(ids))
 	for _, id := range ids {
 		semaphore <- struct{}{}
@@ -47,7 +48,9 @@ func getUsersByIDs(parentCtx context.Context, c *client, ids []int) ([]User, err
 			if user, err := c.GetUserByID(ctx, id); err != nil {
 				errChan <- err
 			} else {
+				mu.Lock()
 				users = append(users, user)
+				mu.Unlock()
 			}
 		}(id)
 	}
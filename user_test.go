@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"path"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -55,6 +56,39 @@ func TestClient_GetUsersByIDs(t *testing.T) {
 		}
 	})
 
+	t.Run("concurrent requests", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(
+			func(req *http.Request) *http.Response {
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id": ` + path.Base(req.URL.Path) + `}`))),
+					StatusCode: http.StatusOK,
+				}
+			},
+			nil,
+		)
+
+		client := gitlab.NewClient(
+			"test_token",
+			gitlab.WithConcurrency(10),
+			gitlab.WithHttpClient(httpClient),
+		)
+
+		ids := make([]int, 0, 100)
+		for id := 1; id <= cap(ids); id++ {
+			ids = append(ids, id)
+		}
+
+		users, err := client.GetUsersByIDs(context.Background(), ids)
+		assert.NoError(t, err)
+
+		resIDs := make([]int, 0, len(users))
+		for _, user := range users {
+			resIDs = append(resIDs, user.ID)
+		}
+		assert.ElementsMatch(t, ids, resIDs)
+	})
+
 	t.Run("error on getting user", func(t *testing.T) {
 		expErr := errors.New("test error")
 
@@ -0,0 +1,203 @@
+// Package gitlab - version
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Feature is an api feature available since some gitlab version
+type Feature string
+
+// Features checked by SupportsFeature
+const (
+	FeatureKeysetPagination      Feature = "keyset_pagination"
+	FeatureMergeRequestReviewers Feature = "merge_request_reviewers"
+	FeatureApprovalRules         Feature = "approval_rules"
+	FeatureMetadata              Feature = "metadata"
+)
+
+// featureVersions contains minimum gitlab versions of features
+var featureVersions = map[Feature]string{
+	FeatureKeysetPagination:      "12.7",
+	FeatureMergeRequestReviewers: "13.7",
+	FeatureApprovalRules:         "12.3",
+	FeatureMetadata:              "15.2",
+}
+
+// ErrUnsupported is returned (wrapped by UnsupportedError) when feature is not supported by gitlab version
+var ErrUnsupported = errors.New("not supported by gitlab version")
+
+type (
+	// Version entity
+	Version struct {
+		Version  string `json:"version"`
+		Revision string `json:"revision"`
+	}
+
+	// Metadata entity contains version and configuration of gitlab instance
+	Metadata struct {
+		Version    string      `json:"version"`
+		Revision   string      `json:"revision"`
+		KAS        MetadataKAS `json:"kas"`
+		Enterprise bool        `json:"enterprise"`
+	}
+
+	// MetadataKAS contains configuration of gitlab agent server for kubernetes
+	MetadataKAS struct {
+		Enabled     bool   `json:"enabled"`
+		ExternalUrl string `json:"externalUrl"`
+		Version     string `json:"version"`
+	}
+
+	// UnsupportedError is returned instead of not found error when feature is not supported by gitlab version
+	UnsupportedError struct {
+		Feature    Feature
+		Version    string
+		MinVersion string
+	}
+
+	// versionCache keeps gitlab version once it is received
+	versionCache struct {
+		mu      sync.Mutex
+		version *Version
+	}
+)
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("%s requires gitlab %s, server version is %s", e.Feature, e.MinVersion, e.Version)
+}
+
+// Unwrap allows to check error by errors.Is(err, ErrUnsupported)
+func (e *UnsupportedError) Unwrap() error {
+	return ErrUnsupported
+}
+
+// AtLeast reports whether version is equal to or greater than other one (e.g. "13.7"),
+// suffixes like "-ee" or "-pre" are ignored
+func (v Version) AtLeast(other string) bool {
+	current, required := parseVersion(v.Version), parseVersion(other)
+	for i := range current {
+		if current[i] != required[i] {
+			return current[i] > required[i]
+		}
+	}
+
+	return true
+}
+
+func getVersion(ctx context.Context, c *client) (Version, error) {
+	resp, err := c.get(ctx, "version")
+	if err != nil {
+		return Version{}, err
+	}
+
+	var version Version
+	if err = json.Unmarshal(resp, &version); err != nil {
+		return Version{}, fmt.Errorf("can't unmarshal version data: %w", err)
+	}
+
+	c.version.set(version)
+
+	return version, nil
+}
+
+func getMetadata(ctx context.Context, c *client) (Metadata, error) {
+	resp, err := c.get(ctx, "metadata")
+	if err != nil {
+		return Metadata{}, c.featureError(ctx, FeatureMetadata, err)
+	}
+
+	var metadata Metadata
+	if err = json.Unmarshal(resp, &metadata); err != nil {
+		return Metadata{}, fmt.Errorf("can't unmarshal metadata data: %w", err)
+	}
+
+	return metadata, nil
+}
+
+func supportsFeature(ctx context.Context, c *client, feature Feature) (bool, error) {
+	minVersion, has := featureVersions[feature]
+	if !has {
+		return false, fmt.Errorf("unknown feature %q", feature)
+	}
+
+	version, err := c.version.get(ctx, c)
+	if err != nil {
+		return false, fmt.Errorf("can't get version from gitlab: %w", err)
+	}
+
+	return version.AtLeast(minVersion), nil
+}
+
+// featureError replaces not found error of feature endpoint by UnsupportedError if gitlab version is too old,
+// other errors are returned as is
+func (c *client) featureError(ctx context.Context, feature Feature, err error) error {
+	var statusErr *statusError
+	if !errors.As(err, &statusErr) || statusErr.code != http.StatusNotFound {
+		return err
+	}
+
+	version, verr := c.version.get(ctx, c)
+	if verr != nil || version.AtLeast(featureVersions[feature]) {
+		return err
+	}
+
+	return &UnsupportedError{Feature: feature, Version: version.Version, MinVersion: featureVersions[feature]}
+}
+
+// requireFeature returns UnsupportedError if gitlab version is too old for feature,
+// it is checked before requests which parameters are silently ignored by old versions
+func (c *client) requireFeature(ctx context.Context, feature Feature) error {
+	version, err := c.version.get(ctx, c)
+	if err != nil {
+		return fmt.Errorf("can't get version from gitlab: %w", err)
+	}
+
+	if !version.AtLeast(featureVersions[feature]) {
+		return &UnsupportedError{Feature: feature, Version: version.Version, MinVersion: featureVersions[feature]}
+	}
+
+	return nil
+}
+
+// get returns cached version or requests it from gitlab, errors are not cached
+func (vc *versionCache) get(ctx context.Context, c *client) (Version, error) {
+	vc.mu.Lock()
+	version := vc.version
+	vc.mu.Unlock()
+
+	if version != nil {
+		return *version, nil
+	}
+
+	return getVersion(ctx, c)
+}
+
+func (vc *versionCache) set(version Version) {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+
+	vc.version = &version
+}
+
+// parseVersion returns major, minor and patch numbers of version
+func parseVersion(version string) [3]int {
+	var res [3]int
+	for i, part := range strings.SplitN(version, ".", len(res)) {
+		end := strings.IndexFunc(part, func(r rune) bool { return r < '0' || r > '9' })
+		if end >= 0 {
+			part = part[:end]
+		}
+
+		res[i], _ = strconv.Atoi(part)
+	}
+
+	return res
+}
@@ -0,0 +1,142 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+func newVersionHTTPClient(version string) *gitlab.MockHTTPClient {
+	httpClient := new(gitlab.MockHTTPClient)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool { return req.URL.Path == "/api/v4/version" })).
+		Return(func(*http.Request) *http.Response {
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"version": "` + version + `", "revision": "abc"}`))),
+				StatusCode: http.StatusOK,
+			}
+		}, nil)
+
+	return httpClient
+}
+
+func TestClient_GetVersion(t *testing.T) {
+	httpClient := newVersionHTTPClient("16.3.1-ee")
+	client := gitlab.NewClient("test_token", gitlab.WithHttpClient(httpClient))
+
+	version, err := client.Instance().Version(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, gitlab.Version{Version: "16.3.1-ee", Revision: "abc"}, version)
+}
+
+func TestClient_GetMetadata(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(`{
+				"version": "15.2-pre",
+				"revision": "c401a659d0c",
+				"kas": {"enabled": true, "externalUrl": "grpc://gitlab.example.com:8150", "version": "15.0.0"},
+				"enterprise": true
+			}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := gitlab.NewClient("test_token", gitlab.WithHttpClient(httpClient))
+
+		metadata, err := client.Instance().Metadata(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, gitlab.Metadata{
+			Version:  "15.2-pre",
+			Revision: "c401a659d0c",
+			KAS: gitlab.MetadataKAS{
+				Enabled:     true,
+				ExternalUrl: "grpc://gitlab.example.com:8150",
+				Version:     "15.0.0",
+			},
+			Enterprise: true,
+		}, metadata)
+	})
+
+	t.Run("unsupported by version", func(t *testing.T) {
+		httpClient := newVersionHTTPClient("14.10.5")
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"message": "404 Not Found"}`))),
+			StatusCode: http.StatusNotFound,
+		}, nil)
+
+		client := gitlab.NewClient("test_token", gitlab.WithHttpClient(httpClient))
+
+		_, err := client.Instance().Metadata(context.Background())
+		assert.True(t, errors.Is(err, gitlab.ErrUnsupported))
+
+		var unsupportedErr *gitlab.UnsupportedError
+		if assert.True(t, errors.As(err, &unsupportedErr)) {
+			assert.Equal(t, gitlab.UnsupportedError{
+				Feature:    gitlab.FeatureMetadata,
+				Version:    "14.10.5",
+				MinVersion: "15.2",
+			}, *unsupportedErr)
+		}
+	})
+}
+
+func TestClient_SupportsFeature(t *testing.T) {
+	t.Run("version is requested once", func(t *testing.T) {
+		httpClient := newVersionHTTPClient("13.7.0")
+		client := gitlab.NewClient("test_token", gitlab.WithHttpClient(httpClient))
+
+		for feature, supported := range map[gitlab.Feature]bool{
+			gitlab.FeatureKeysetPagination:      true,
+			gitlab.FeatureMergeRequestReviewers: true,
+			gitlab.FeatureApprovalRules:         true,
+			gitlab.FeatureMetadata:              false,
+		} {
+			res, err := client.Instance().SupportsFeature(context.Background(), feature)
+			assert.NoError(t, err)
+			assert.Equal(t, supported, res, feature)
+		}
+
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("unknown feature", func(t *testing.T) {
+		client := gitlab.NewClient("test_token", gitlab.WithHttpClient(new(gitlab.MockHTTPClient)))
+
+		_, err := client.Instance().SupportsFeature(context.Background(), gitlab.Feature("unknown"))
+		assert.EqualError(t, err, `unknown feature "unknown"`)
+	})
+
+	t.Run("error on getting version", func(t *testing.T) {
+		httpClient := new(gitlab.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, errors.New("test error"))
+
+		client := gitlab.NewClient("test_token", gitlab.WithHttpClient(httpClient))
+
+		_, err := client.Instance().SupportsFeature(context.Background(), gitlab.FeatureApprovalRules)
+		assert.Error(t, err)
+	})
+}
+
+func TestVersion_AtLeast(t *testing.T) {
+	for _, tc := range []struct {
+		version, other string
+		res            bool
+	}{
+		{"16.3.1-ee", "13.7", true},
+		{"13.7.0", "13.7", true},
+		{"13.6.9", "13.7", false},
+		{"15.2-pre", "15.2", true},
+		{"9.5.10", "12.3", false},
+		{"", "12.3", false},
+	} {
+		assert.Equal(t, tc.res, gitlab.Version{Version: tc.version}.AtLeast(tc.other), tc.version)
+	}
+}
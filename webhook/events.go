@@ -0,0 +1,337 @@
+// Package webhook - events
+package webhook
+
+import (
+	"encoding/json"
+
+	"github.com/kryabinin/go-gitlab"
+)
+
+type (
+	// Project of the event
+	Project struct {
+		ID                int    `json:"id"`
+		Name              string `json:"name"`
+		Description       string `json:"description"`
+		WebUrl            string `json:"web_url"`
+		AvatarUrl         string `json:"avatar_url"`
+		GitSshUrl         string `json:"git_ssh_url"`
+		GitHttpUrl        string `json:"git_http_url"`
+		Namespace         string `json:"namespace"`
+		PathWithNamespace string `json:"path_with_namespace"`
+		DefaultBranch     string `json:"default_branch"`
+	}
+
+	// Repository of the event
+	Repository struct {
+		Name        string `json:"name"`
+		Url         string `json:"url"`
+		Description string `json:"description"`
+		Homepage    string `json:"homepage"`
+	}
+
+	// Commit of the event
+	Commit struct {
+		ID        string       `json:"id"`
+		Message   string       `json:"message"`
+		Title     string       `json:"title"`
+		Timestamp *gitlab.Time `json:"timestamp"`
+		Url       string       `json:"url"`
+		Author    CommitAuthor `json:"author"`
+		Added     []string     `json:"added"`
+		Modified  []string     `json:"modified"`
+		Removed   []string     `json:"removed"`
+	}
+
+	// CommitAuthor is an author of the commit
+	CommitAuthor struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+
+	// Label of merge request or issue
+	Label struct {
+		ID          int    `json:"id"`
+		Title       string `json:"title"`
+		Color       string `json:"color"`
+		Description string `json:"description"`
+	}
+
+	// Change contains previous and current values of changed attribute
+	Change struct {
+		Previous json.RawMessage `json:"previous"`
+		Current  json.RawMessage `json:"current"`
+	}
+
+	// PushEvent is a payload of push and tag push events
+	PushEvent struct {
+		ObjectKind        string     `json:"object_kind"`
+		EventName         string     `json:"event_name"`
+		Before            string     `json:"before"`
+		After             string     `json:"after"`
+		Ref               string     `json:"ref"`
+		CheckoutSha       string     `json:"checkout_sha"`
+		Message           string     `json:"message"`
+		UserID            int        `json:"user_id"`
+		UserName          string     `json:"user_name"`
+		UserUsername      string     `json:"user_username"`
+		UserEmail         string     `json:"user_email"`
+		UserAvatar        string     `json:"user_avatar"`
+		ProjectID         int        `json:"project_id"`
+		Project           Project    `json:"project"`
+		Commits           []Commit   `json:"commits"`
+		TotalCommitsCount int        `json:"total_commits_count"`
+		Repository        Repository `json:"repository"`
+	}
+
+	// MergeRequestEvent is a payload of merge request event
+	MergeRequestEvent struct {
+		ObjectKind       string                 `json:"object_kind"`
+		EventType        string                 `json:"event_type"`
+		User             gitlab.NoteAuthor      `json:"user"`
+		Project          Project                `json:"project"`
+		Repository       Repository             `json:"repository"`
+		ObjectAttributes MergeRequestAttributes `json:"object_attributes"`
+		Labels           []Label                `json:"labels"`
+		Assignees        []gitlab.NoteAuthor    `json:"assignees"`
+		Reviewers        []gitlab.NoteAuthor    `json:"reviewers"`
+		Changes          map[string]Change      `json:"changes"`
+	}
+
+	// MergeRequestAttributes is a merge request of the event
+	MergeRequestAttributes struct {
+		ID              int          `json:"id"`
+		IID             int          `json:"iid"`
+		Title           string       `json:"title"`
+		Description     string       `json:"description"`
+		State           string       `json:"state"`
+		MergeStatus     string       `json:"merge_status"`
+		Draft           bool         `json:"draft"`
+		SourceBranch    string       `json:"source_branch"`
+		TargetBranch    string       `json:"target_branch"`
+		SourceProjectID int          `json:"source_project_id"`
+		TargetProjectID int          `json:"target_project_id"`
+		AuthorID        int          `json:"author_id"`
+		AssigneeIDs     []int        `json:"assignee_ids"`
+		ReviewerIDs     []int        `json:"reviewer_ids"`
+		LastCommit      *Commit      `json:"last_commit"`
+		Url             string       `json:"url"`
+		Action          string       `json:"action"`
+		OldRev          string       `json:"oldrev"`
+		CreatedAt       *gitlab.Time `json:"created_at"`
+		UpdatedAt       *gitlab.Time `json:"updated_at"`
+	}
+
+	// NoteEvent is a payload of comment event, Note.Author is the user of the event
+	NoteEvent struct {
+		ObjectKind   string
+		EventType    string
+		User         gitlab.NoteAuthor
+		ProjectID    int
+		Project      Project
+		Repository   Repository
+		Note         gitlab.Note
+		DiscussionID string
+		Url          string
+		// one of MergeRequest, Issue or Commit is set depending on Note.NoteableType
+		MergeRequest *MergeRequestAttributes
+		Issue        *IssueAttributes
+		Commit       *Commit
+	}
+
+	// IssueEvent is a payload of issue event
+	IssueEvent struct {
+		ObjectKind       string              `json:"object_kind"`
+		EventType        string              `json:"event_type"`
+		User             gitlab.NoteAuthor   `json:"user"`
+		Project          Project             `json:"project"`
+		Repository       Repository          `json:"repository"`
+		ObjectAttributes IssueAttributes     `json:"object_attributes"`
+		Labels           []Label             `json:"labels"`
+		Assignees        []gitlab.NoteAuthor `json:"assignees"`
+		Changes          map[string]Change   `json:"changes"`
+	}
+
+	// IssueAttributes is an issue of the event
+	IssueAttributes struct {
+		ID           int          `json:"id"`
+		IID          int          `json:"iid"`
+		ProjectID    int          `json:"project_id"`
+		Title        string       `json:"title"`
+		Description  string       `json:"description"`
+		State        string       `json:"state"`
+		Confidential bool         `json:"confidential"`
+		AuthorID     int          `json:"author_id"`
+		AssigneeIDs  []int        `json:"assignee_ids"`
+		DueDate      *gitlab.Date `json:"due_date"`
+		Url          string       `json:"url"`
+		Action       string       `json:"action"`
+		CreatedAt    *gitlab.Time `json:"created_at"`
+		UpdatedAt    *gitlab.Time `json:"updated_at"`
+		ClosedAt     *gitlab.Time `json:"closed_at"`
+	}
+
+	// PipelineEvent is a payload of pipeline event
+	PipelineEvent struct {
+		ObjectKind       string                  `json:"object_kind"`
+		ObjectAttributes PipelineAttributes      `json:"object_attributes"`
+		MergeRequest     *MergeRequestAttributes `json:"merge_request"`
+		User             gitlab.NoteAuthor       `json:"user"`
+		Project          Project                 `json:"project"`
+		Commit           *Commit                 `json:"commit"`
+		Builds           []PipelineBuild         `json:"builds"`
+	}
+
+	// PipelineAttributes is a pipeline of the event
+	PipelineAttributes struct {
+		ID             int          `json:"id"`
+		IID            int          `json:"iid"`
+		Ref            string       `json:"ref"`
+		Tag            bool         `json:"tag"`
+		Sha            string       `json:"sha"`
+		BeforeSha      string       `json:"before_sha"`
+		Source         string       `json:"source"`
+		Status         string       `json:"status"`
+		DetailedStatus string       `json:"detailed_status"`
+		Stages         []string     `json:"stages"`
+		Duration       float64      `json:"duration"`
+		QueuedDuration float64      `json:"queued_duration"`
+		Url            string       `json:"url"`
+		CreatedAt      *gitlab.Time `json:"created_at"`
+		FinishedAt     *gitlab.Time `json:"finished_at"`
+	}
+
+	// PipelineBuild is a job of the pipeline event
+	PipelineBuild struct {
+		ID            int               `json:"id"`
+		Stage         string            `json:"stage"`
+		Name          string            `json:"name"`
+		Status        string            `json:"status"`
+		Duration      float64           `json:"duration"`
+		AllowFailure  bool              `json:"allow_failure"`
+		FailureReason string            `json:"failure_reason"`
+		User          gitlab.NoteAuthor `json:"user"`
+		CreatedAt     *gitlab.Time      `json:"created_at"`
+		StartedAt     *gitlab.Time      `json:"started_at"`
+		FinishedAt    *gitlab.Time      `json:"finished_at"`
+	}
+
+	// JobEvent is a payload of job event
+	JobEvent struct {
+		ObjectKind         string            `json:"object_kind"`
+		Ref                string            `json:"ref"`
+		Tag                bool              `json:"tag"`
+		BeforeSha          string            `json:"before_sha"`
+		Sha                string            `json:"sha"`
+		BuildID            int               `json:"build_id"`
+		BuildName          string            `json:"build_name"`
+		BuildStage         string            `json:"build_stage"`
+		BuildStatus        string            `json:"build_status"`
+		BuildDuration      float64           `json:"build_duration"`
+		BuildAllowFailure  bool              `json:"build_allow_failure"`
+		BuildFailureReason string            `json:"build_failure_reason"`
+		PipelineID         int               `json:"pipeline_id"`
+		ProjectID          int               `json:"project_id"`
+		ProjectName        string            `json:"project_name"`
+		User               gitlab.NoteAuthor `json:"user"`
+		Repository         Repository        `json:"repository"`
+		BuildCreatedAt     *gitlab.Time      `json:"build_created_at"`
+		BuildStartedAt     *gitlab.Time      `json:"build_started_at"`
+		BuildFinishedAt    *gitlab.Time      `json:"build_finished_at"`
+	}
+
+	// ReleaseEvent is a payload of release event
+	ReleaseEvent struct {
+		ObjectKind  string       `json:"object_kind"`
+		ID          int          `json:"id"`
+		Name        string       `json:"name"`
+		Tag         string       `json:"tag"`
+		Description string       `json:"description"`
+		Url         string       `json:"url"`
+		Action      string       `json:"action"`
+		Project     Project      `json:"project"`
+		Commit      Commit       `json:"commit"`
+		CreatedAt   *gitlab.Time `json:"created_at"`
+		ReleasedAt  *gitlab.Time `json:"released_at"`
+	}
+
+	// DeploymentEvent is a payload of deployment event
+	DeploymentEvent struct {
+		ObjectKind      string            `json:"object_kind"`
+		Status          string            `json:"status"`
+		DeploymentID    int               `json:"deployment_id"`
+		DeployableID    int               `json:"deployable_id"`
+		DeployableUrl   string            `json:"deployable_url"`
+		Environment     string            `json:"environment"`
+		ShortSha        string            `json:"short_sha"`
+		User            gitlab.NoteAuthor `json:"user"`
+		UserUrl         string            `json:"user_url"`
+		CommitUrl       string            `json:"commit_url"`
+		CommitTitle     string            `json:"commit_title"`
+		Project         Project           `json:"project"`
+		StatusChangedAt *gitlab.Time      `json:"status_changed_at"`
+	}
+)
+
+// UnmarshalJSON implements json.Unmarshaler,
+// note attributes of the payload are converted to gitlab.Note the client returns
+func (e *NoteEvent) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		ObjectKind       string                  `json:"object_kind"`
+		EventType        string                  `json:"event_type"`
+		User             gitlab.NoteAuthor       `json:"user"`
+		ProjectID        int                     `json:"project_id"`
+		Project          Project                 `json:"project"`
+		Repository       Repository              `json:"repository"`
+		ObjectAttributes json.RawMessage         `json:"object_attributes"`
+		MergeRequest     *MergeRequestAttributes `json:"merge_request"`
+		Issue            *IssueAttributes        `json:"issue"`
+		Commit           *Commit                 `json:"commit"`
+	}{}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var note gitlab.Note
+	if err := json.Unmarshal(aux.ObjectAttributes, &note); err != nil {
+		return err
+	}
+
+	attributes := struct {
+		Note         string `json:"note"`
+		DiscussionID string `json:"discussion_id"`
+		Url          string `json:"url"`
+	}{}
+
+	if err := json.Unmarshal(aux.ObjectAttributes, &attributes); err != nil {
+		return err
+	}
+
+	note.Body = attributes.Note
+	note.Author = aux.User
+
+	switch {
+	case aux.MergeRequest != nil:
+		note.NoteableIID = aux.MergeRequest.IID
+	case aux.Issue != nil:
+		note.NoteableIID = aux.Issue.IID
+	}
+
+	*e = NoteEvent{
+		ObjectKind:   aux.ObjectKind,
+		EventType:    aux.EventType,
+		User:         aux.User,
+		ProjectID:    aux.ProjectID,
+		Project:      aux.Project,
+		Repository:   aux.Repository,
+		Note:         note,
+		DiscussionID: attributes.DiscussionID,
+		Url:          attributes.Url,
+		MergeRequest: aux.MergeRequest,
+		Issue:        aux.Issue,
+		Commit:       aux.Commit,
+	}
+
+	return nil
+}
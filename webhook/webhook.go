@@ -0,0 +1,292 @@
+// Package webhook - gitlab webhooks receiver
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// EventType is a value of X-Gitlab-Event header
+type EventType string
+
+// Event types
+const (
+	EventPush         EventType = "Push Hook"
+	EventTagPush      EventType = "Tag Push Hook"
+	EventMergeRequest EventType = "Merge Request Hook"
+	EventNote         EventType = "Note Hook"
+	// EventConfidentialNote is handled by OnNote handler
+	EventConfidentialNote EventType = "Confidential Note Hook"
+	EventPipeline         EventType = "Pipeline Hook"
+	EventJob              EventType = "Job Hook"
+	EventIssue            EventType = "Issue Hook"
+	// EventConfidentialIssue is handled by OnIssue handler
+	EventConfidentialIssue EventType = "Confidential Issue Hook"
+	EventRelease           EventType = "Release Hook"
+	EventDeployment        EventType = "Deployment Hook"
+)
+
+const (
+	eventHeader = "X-Gitlab-Event"
+	tokenHeader = "X-Gitlab-Token"
+
+	defaultMaxBodySize = 25 << 20
+)
+
+type (
+	// Option to use optional parameters in webhook handler
+	Option interface {
+		apply(h *handler)
+	}
+
+	handler struct {
+		token        string
+		maxBodySize  int64
+		handlers     map[EventType]eventHandler
+		errorHandler func(r *http.Request, err error)
+	}
+
+	eventHandler func(ctx context.Context, data []byte) error
+
+	withEventHandler struct {
+		eventTypes []EventType
+		handle     eventHandler
+	}
+
+	withMaxBodySize struct {
+		maxBodySize int64
+	}
+
+	withErrorHandler struct {
+		errorHandler func(r *http.Request, err error)
+	}
+
+	decodeError struct {
+		err error
+	}
+)
+
+// NewHandler is webhook handler constructor, token is a secret token of the hook (verification is off if empty)
+func NewHandler(token string, opts ...Option) http.Handler {
+	h := &handler{
+		token:        token,
+		maxBodySize:  defaultMaxBodySize,
+		handlers:     map[EventType]eventHandler{},
+		errorHandler: func(*http.Request, error) {},
+	}
+
+	for _, opt := range opts {
+		opt.apply(h)
+	}
+
+	return h
+}
+
+// ServeHTTP verifies token, decodes payload and calls handler of the event,
+// events without handlers are acknowledged with 204 status code
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if h.token != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get(tokenHeader)), []byte(h.token)) != 1 {
+		h.errorHandler(r, errors.New("invalid webhook token"))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	handle, has := h.handlers[EventType(r.Header.Get(eventHeader))]
+	if !has {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(r.Body, h.maxBodySize+1))
+	if err != nil {
+		h.errorHandler(r, fmt.Errorf("can't read webhook body: %w", err))
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if int64(len(data)) > h.maxBodySize {
+		h.errorHandler(r, fmt.Errorf("webhook body exceeds %d bytes", h.maxBodySize))
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if err = handle(r.Context(), data); err != nil {
+		h.errorHandler(r, err)
+
+		var decodeErr *decodeError
+		if errors.As(err, &decodeErr) {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *decodeError) Error() string {
+	return fmt.Sprintf("can't decode webhook payload: %s", e.err)
+}
+
+func (e *decodeError) Unwrap() error {
+	return e.err
+}
+
+func decode(data []byte, event interface{}) error {
+	if err := json.Unmarshal(data, event); err != nil {
+		return &decodeError{err: err}
+	}
+
+	return nil
+}
+
+func (opt withEventHandler) apply(h *handler) {
+	for _, eventType := range opt.eventTypes {
+		h.handlers[eventType] = opt.handle
+	}
+}
+
+// WithMaxBodySize replaces default (25MB) limit of payload size
+func WithMaxBodySize(maxBodySize int64) Option {
+	return withMaxBodySize{maxBodySize: maxBodySize}
+}
+
+func (opt withMaxBodySize) apply(h *handler) {
+	h.maxBodySize = opt.maxBodySize
+}
+
+// WithErrorHandler sets callback for invalid requests and errors returned by event handlers
+func WithErrorHandler(errorHandler func(r *http.Request, err error)) Option {
+	return withErrorHandler{errorHandler: errorHandler}
+}
+
+func (opt withErrorHandler) apply(h *handler) {
+	h.errorHandler = opt.errorHandler
+}
+
+// OnPush sets handler of push events
+func OnPush(fn func(ctx context.Context, event PushEvent) error) Option {
+	return on(func(ctx context.Context, data []byte) error {
+		var event PushEvent
+		if err := decode(data, &event); err != nil {
+			return err
+		}
+
+		return fn(ctx, event)
+	}, EventPush)
+}
+
+// OnTagPush sets handler of tag push events
+func OnTagPush(fn func(ctx context.Context, event PushEvent) error) Option {
+	return on(func(ctx context.Context, data []byte) error {
+		var event PushEvent
+		if err := decode(data, &event); err != nil {
+			return err
+		}
+
+		return fn(ctx, event)
+	}, EventTagPush)
+}
+
+// OnMergeRequest sets handler of merge request events
+func OnMergeRequest(fn func(ctx context.Context, event MergeRequestEvent) error) Option {
+	return on(func(ctx context.Context, data []byte) error {
+		var event MergeRequestEvent
+		if err := decode(data, &event); err != nil {
+			return err
+		}
+
+		return fn(ctx, event)
+	}, EventMergeRequest)
+}
+
+// OnNote sets handler of comment events (including confidential ones)
+func OnNote(fn func(ctx context.Context, event NoteEvent) error) Option {
+	return on(func(ctx context.Context, data []byte) error {
+		var event NoteEvent
+		if err := decode(data, &event); err != nil {
+			return err
+		}
+
+		return fn(ctx, event)
+	}, EventNote, EventConfidentialNote)
+}
+
+// OnPipeline sets handler of pipeline events
+func OnPipeline(fn func(ctx context.Context, event PipelineEvent) error) Option {
+	return on(func(ctx context.Context, data []byte) error {
+		var event PipelineEvent
+		if err := decode(data, &event); err != nil {
+			return err
+		}
+
+		return fn(ctx, event)
+	}, EventPipeline)
+}
+
+// OnJob sets handler of job events
+func OnJob(fn func(ctx context.Context, event JobEvent) error) Option {
+	return on(func(ctx context.Context, data []byte) error {
+		var event JobEvent
+		if err := decode(data, &event); err != nil {
+			return err
+		}
+
+		return fn(ctx, event)
+	}, EventJob)
+}
+
+// OnIssue sets handler of issue events (including confidential ones)
+func OnIssue(fn func(ctx context.Context, event IssueEvent) error) Option {
+	return on(func(ctx context.Context, data []byte) error {
+		var event IssueEvent
+		if err := decode(data, &event); err != nil {
+			return err
+		}
+
+		return fn(ctx, event)
+	}, EventIssue, EventConfidentialIssue)
+}
+
+// OnRelease sets handler of release events
+func OnRelease(fn func(ctx context.Context, event ReleaseEvent) error) Option {
+	return on(func(ctx context.Context, data []byte) error {
+		var event ReleaseEvent
+		if err := decode(data, &event); err != nil {
+			return err
+		}
+
+		return fn(ctx, event)
+	}, EventRelease)
+}
+
+// OnDeployment sets handler of deployment events
+func OnDeployment(fn func(ctx context.Context, event DeploymentEvent) error) Option {
+	return on(func(ctx context.Context, data []byte) error {
+		var event DeploymentEvent
+		if err := decode(data, &event); err != nil {
+			return err
+		}
+
+		return fn(ctx, event)
+	}, EventDeployment)
+}
+
+// on returns option setting handle as handler of event types
+func on(handle eventHandler, eventTypes ...EventType) Option {
+	return withEventHandler{eventTypes: eventTypes, handle: handle}
+}
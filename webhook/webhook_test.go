@@ -0,0 +1,328 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-gitlab"
+	"github.com/kryabinin/go-gitlab/webhook"
+)
+
+func sendEvent(handler http.Handler, token string, eventType webhook.EventType, payload string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	req.Header.Set("X-Gitlab-Token", token)
+	req.Header.Set("X-Gitlab-Event", string(eventType))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	t.Run("invalid token", func(t *testing.T) {
+		var called, errorHandled bool
+
+		handler := webhook.NewHandler(
+			"secret",
+			webhook.OnPush(func(ctx context.Context, event webhook.PushEvent) error {
+				called = true
+				return nil
+			}),
+			webhook.WithErrorHandler(func(r *http.Request, err error) {
+				errorHandled = true
+			}),
+		)
+
+		rec := sendEvent(handler, "wrong", webhook.EventPush, `{}`)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.False(t, called)
+		assert.True(t, errorHandled)
+	})
+
+	t.Run("invalid method", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/webhook", nil)
+		rec := httptest.NewRecorder()
+
+		webhook.NewHandler("secret").ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+
+	t.Run("event without handler", func(t *testing.T) {
+		rec := sendEvent(webhook.NewHandler("secret"), "secret", webhook.EventJob, `{}`)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		handler := webhook.NewHandler("secret", webhook.OnPush(func(ctx context.Context, event webhook.PushEvent) error {
+			return nil
+		}))
+
+		rec := sendEvent(handler, "secret", webhook.EventPush, `{`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("too large payload", func(t *testing.T) {
+		handler := webhook.NewHandler(
+			"secret",
+			webhook.WithMaxBodySize(10),
+			webhook.OnPush(func(ctx context.Context, event webhook.PushEvent) error {
+				return nil
+			}),
+		)
+
+		rec := sendEvent(handler, "secret", webhook.EventPush, `{"ref": "refs/heads/master"}`)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+
+	t.Run("error in event handler", func(t *testing.T) {
+		var handledErr error
+		expErr := errors.New("test error")
+
+		handler := webhook.NewHandler(
+			"secret",
+			webhook.OnPush(func(ctx context.Context, event webhook.PushEvent) error {
+				return expErr
+			}),
+			webhook.WithErrorHandler(func(r *http.Request, err error) {
+				handledErr = err
+			}),
+		)
+
+		rec := sendEvent(handler, "secret", webhook.EventPush, `{}`)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.True(t, errors.Is(handledErr, expErr))
+	})
+}
+
+func TestOnPush(t *testing.T) {
+	var received webhook.PushEvent
+
+	handler := webhook.NewHandler("secret", webhook.OnPush(func(ctx context.Context, event webhook.PushEvent) error {
+		received = event
+		return nil
+	}))
+
+	rec := sendEvent(handler, "secret", webhook.EventPush, `{
+		"object_kind": "push",
+		"ref": "refs/heads/master",
+		"user_username": "john",
+		"project": {"id": 10, "path_with_namespace": "group/project"},
+		"commits": [{"id": "abc", "timestamp": "2020-10-01T13:20:30+03:00", "author": {"name": "John"}, "added": ["a.go"]}],
+		"total_commits_count": 1
+	}`)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	timestamp := time.Date(2020, 10, 1, 10, 20, 30, 0, time.UTC)
+	assert.Equal(t, "refs/heads/master", received.Ref)
+	assert.Equal(t, webhook.Project{ID: 10, PathWithNamespace: "group/project"}, received.Project)
+	assert.Equal(t, 1, len(received.Commits))
+	assert.Equal(t, "abc", received.Commits[0].ID)
+	assert.Equal(t, []string{"a.go"}, received.Commits[0].Added)
+	assert.True(t, timestamp.Equal(received.Commits[0].Timestamp.Time))
+}
+
+func TestOnNote(t *testing.T) {
+	for _, eventType := range []webhook.EventType{webhook.EventNote, webhook.EventConfidentialNote} {
+		var received webhook.NoteEvent
+
+		handler := webhook.NewHandler("secret", webhook.OnNote(func(ctx context.Context, event webhook.NoteEvent) error {
+			received = event
+			return nil
+		}))
+
+		rec := sendEvent(handler, "secret", eventType, `{
+			"object_kind": "note",
+			"event_type": "note",
+			"user": {"id": 5, "name": "John", "username": "john"},
+			"project_id": 10,
+			"object_attributes": {
+				"id": 100,
+				"note": "looks good",
+				"noteable_type": "MergeRequest",
+				"noteable_id": 200,
+				"type": "DiffNote",
+				"discussion_id": "d1",
+				"created_at": "2020-10-01 10:20:30 UTC",
+				"updated_at": "2020-10-01 10:20:30 UTC",
+				"position": {"base_sha": "a", "start_sha": "b", "head_sha": "c", "new_path": "main.go", "new_line": 5, "position_type": "text"},
+				"url": "http://gitlab.test.com/group/project/-/merge_requests/20#note_100"
+			},
+			"merge_request": {"id": 200, "iid": 20, "created_at": "2020-09-30T10:00:00Z"}
+		}`)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		createdAt := gitlab.Time{Time: time.Date(2020, 10, 1, 10, 20, 30, 0, time.UTC)}
+		author := gitlab.NoteAuthor{ID: 5, Name: "John", UserName: "john"}
+
+		assert.Equal(t, gitlab.Note{
+			ID:           100,
+			Type:         "DiffNote",
+			Body:         "looks good",
+			Author:       author,
+			CreatedAt:    &createdAt,
+			UpdatedAt:    &createdAt,
+			NoteableID:   200,
+			NoteableType: "MergeRequest",
+			NoteableIID:  20,
+			Position: gitlab.Position{
+				BaseSha:      "a",
+				StartSha:     "b",
+				HeadSha:      "c",
+				NewPath:      "main.go",
+				NewLine:      5,
+				PositionType: "text",
+			},
+		}, received.Note)
+		assert.Equal(t, author, received.User)
+		assert.Equal(t, "d1", received.DiscussionID)
+		assert.Equal(t, 20, received.MergeRequest.IID)
+		assert.Nil(t, received.Issue)
+	}
+}
+
+func TestOnMergeRequest(t *testing.T) {
+	var received webhook.MergeRequestEvent
+
+	handler := webhook.NewHandler("", webhook.OnMergeRequest(func(ctx context.Context, event webhook.MergeRequestEvent) error {
+		received = event
+		return nil
+	}))
+
+	rec := sendEvent(handler, "", webhook.EventMergeRequest, `{
+		"object_kind": "merge_request",
+		"user": {"id": 5},
+		"object_attributes": {
+			"iid": 20,
+			"action": "update",
+			"created_at": "2020-10-01 10:20:30 UTC",
+			"updated_at": null,
+			"last_commit": {"id": "abc", "timestamp": "2020-10-01T10:20:30Z"}
+		},
+		"reviewers": [{"id": 6}],
+		"changes": {"title": {"previous": "Draft: test", "current": "test"}}
+	}`)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	createdAt := time.Date(2020, 10, 1, 10, 20, 30, 0, time.UTC)
+	assert.Equal(t, 20, received.ObjectAttributes.IID)
+	assert.Equal(t, "update", received.ObjectAttributes.Action)
+	assert.True(t, createdAt.Equal(received.ObjectAttributes.CreatedAt.Time))
+	assert.Nil(t, received.ObjectAttributes.UpdatedAt)
+	assert.Equal(t, "abc", received.ObjectAttributes.LastCommit.ID)
+	assert.Equal(t, []gitlab.NoteAuthor{{ID: 6}}, received.Reviewers)
+	assert.JSONEq(t, `"Draft: test"`, string(received.Changes["title"].Previous))
+	assert.JSONEq(t, `"test"`, string(received.Changes["title"].Current))
+}
+
+func TestOnIssue(t *testing.T) {
+	var received webhook.IssueEvent
+
+	handler := webhook.NewHandler("secret", webhook.OnIssue(func(ctx context.Context, event webhook.IssueEvent) error {
+		received = event
+		return nil
+	}))
+
+	rec := sendEvent(handler, "secret", webhook.EventConfidentialIssue, `{
+		"object_kind": "issue",
+		"object_attributes": {"iid": 30, "confidential": true, "due_date": "2020-10-15"}
+	}`)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	assert.Equal(t, 30, received.ObjectAttributes.IID)
+	assert.True(t, received.ObjectAttributes.Confidential)
+	assert.Equal(t, "2020-10-15", received.ObjectAttributes.DueDate.String())
+}
+
+func TestOnPipeline(t *testing.T) {
+	var received webhook.PipelineEvent
+
+	handler := webhook.NewHandler("secret", webhook.OnPipeline(func(ctx context.Context, event webhook.PipelineEvent) error {
+		received = event
+		return nil
+	}))
+
+	rec := sendEvent(handler, "secret", webhook.EventPipeline, `{
+		"object_kind": "pipeline",
+		"object_attributes": {"id": 1, "status": "failed", "stages": ["build", "test"], "created_at": "2020-10-01 10:20:30 UTC"},
+		"builds": [{"id": 2, "name": "test", "status": "failed", "started_at": "2020-10-01 10:21:00 UTC", "finished_at": null}]
+	}`)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	assert.Equal(t, "failed", received.ObjectAttributes.Status)
+	assert.Equal(t, []string{"build", "test"}, received.ObjectAttributes.Stages)
+	assert.Equal(t, 1, len(received.Builds))
+	assert.True(t, time.Date(2020, 10, 1, 10, 21, 0, 0, time.UTC).Equal(received.Builds[0].StartedAt.Time))
+	assert.Nil(t, received.Builds[0].FinishedAt)
+}
+
+func TestOnJob(t *testing.T) {
+	var received webhook.JobEvent
+
+	handler := webhook.NewHandler("secret", webhook.OnJob(func(ctx context.Context, event webhook.JobEvent) error {
+		received = event
+		return nil
+	}))
+
+	rec := sendEvent(handler, "secret", webhook.EventJob, `{
+		"object_kind": "build",
+		"build_id": 2,
+		"build_status": "success",
+		"build_started_at": "2020-10-01 10:21:00 UTC",
+		"build_finished_at": "2020-10-01 10:22:00 UTC"
+	}`)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	assert.Equal(t, 2, received.BuildID)
+	assert.Equal(t, time.Minute, received.BuildFinishedAt.Sub(received.BuildStartedAt.Time))
+	assert.Nil(t, received.BuildCreatedAt)
+}
+
+func TestOnRelease(t *testing.T) {
+	var received webhook.ReleaseEvent
+
+	handler := webhook.NewHandler("secret", webhook.OnRelease(func(ctx context.Context, event webhook.ReleaseEvent) error {
+		received = event
+		return nil
+	}))
+
+	rec := sendEvent(handler, "secret", webhook.EventRelease, `{
+		"object_kind": "release",
+		"tag": "v1.0.0",
+		"action": "create",
+		"released_at": "2020-10-01 10:20:30 UTC",
+		"commit": {"id": "abc"}
+	}`)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	assert.Equal(t, "v1.0.0", received.Tag)
+	assert.Equal(t, "abc", received.Commit.ID)
+	assert.True(t, time.Date(2020, 10, 1, 10, 20, 30, 0, time.UTC).Equal(received.ReleasedAt.Time))
+}
+
+func TestOnDeployment(t *testing.T) {
+	var received webhook.DeploymentEvent
+
+	handler := webhook.NewHandler("secret", webhook.OnDeployment(func(ctx context.Context, event webhook.DeploymentEvent) error {
+		received = event
+		return nil
+	}))
+
+	rec := sendEvent(handler, "secret", webhook.EventDeployment, `{
+		"object_kind": "deployment",
+		"status": "success",
+		"environment": "production",
+		"status_changed_at": "2020-10-01 10:20:30 +0300"
+	}`)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	assert.Equal(t, "production", received.Environment)
+	assert.True(t, time.Date(2020, 10, 1, 7, 20, 30, 0, time.UTC).Equal(received.StatusChangedAt.Time))
+}